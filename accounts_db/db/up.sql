@@ -4,9 +4,140 @@ CREATE EXTENSION IF NOT EXISTS "uuid-ossp"
 CREATE TABLE accounts
 (
     id uuid NOT NULL DEFAULT uuid_generate_v4(),
-    email text NOT NULL UNIQUE,
+    -- The local part keeps its case, the emails are unique case-insensitively.
+    email text NOT NULL,
     password_hash text NOT NULL,
     registration_date date NOT NULL DEFAULT now(),
+    is_admin boolean NOT NULL DEFAULT false,
+    -- The time after which the account, scheduled for deletion, is purged, NULL if the account is active.
+    purge_at timestamptz,
+    -- The time when the account was suspended by the moderation, NULL if the account isn't suspended.
+    suspended_at timestamptz,
+    suspension_reason text NOT NULL DEFAULT '',
+    -- Whether the emails sent to the account bounce.
+    email_undeliverable boolean NOT NULL DEFAULT false,
     CONSTRAINT account_id_pkey PRIMARY KEY (id)
 );
-GRANT SELECT,DELETE,UPDATE,INSERT ON accounts TO accounts_service;
\ No newline at end of file
+CREATE UNIQUE INDEX accounts_email_lower_idx ON accounts (lower(email));
+CREATE INDEX accounts_purge_at_idx ON accounts (purge_at) WHERE purge_at IS NOT NULL;
+GRANT SELECT,DELETE,UPDATE,INSERT ON accounts TO accounts_service;
+
+CREATE TABLE access_tokens
+(
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    account_id uuid NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    name text NOT NULL,
+    token_hash text NOT NULL UNIQUE,
+    scopes text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    expires_at timestamptz,
+    last_used_at timestamptz,
+    CONSTRAINT access_token_id_pkey PRIMARY KEY (id),
+    CONSTRAINT access_token_account_name_unique UNIQUE (account_id, name)
+);
+GRANT SELECT,DELETE,UPDATE,INSERT ON access_tokens TO accounts_service;
+
+CREATE TABLE service_accounts
+(
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    owner_id uuid NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    name text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT service_account_id_pkey PRIMARY KEY (id),
+    CONSTRAINT service_account_owner_name_unique UNIQUE (owner_id, name)
+);
+GRANT SELECT,DELETE,UPDATE,INSERT ON service_accounts TO accounts_service;
+
+CREATE TABLE service_account_credentials
+(
+    client_id text NOT NULL,
+    service_account_id uuid NOT NULL REFERENCES service_accounts(id) ON DELETE CASCADE,
+    secret_hash text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT service_account_credentials_pkey PRIMARY KEY (client_id)
+);
+GRANT SELECT,DELETE,UPDATE,INSERT ON service_account_credentials TO accounts_service;
+
+CREATE TABLE invitations
+(
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    code_hash text NOT NULL UNIQUE,
+    -- The email the invitation is bound to, empty if any email can be registered with the invitation.
+    email text NOT NULL DEFAULT '',
+    max_uses integer NOT NULL,
+    uses integer NOT NULL DEFAULT 0,
+    created_by uuid REFERENCES accounts(id) ON DELETE SET NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    expires_at timestamptz,
+    CONSTRAINT invitation_id_pkey PRIMARY KEY (id),
+    CONSTRAINT invitation_uses_check CHECK (uses <= max_uses)
+);
+GRANT SELECT,DELETE,UPDATE,INSERT ON invitations TO accounts_service;
+
+CREATE TABLE known_devices
+(
+    account_id uuid NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    machine_id text NOT NULL,
+    ip_range cidr NOT NULL,
+    first_seen_at timestamptz NOT NULL DEFAULT now(),
+    last_seen_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT known_device_pkey PRIMARY KEY (account_id, machine_id, ip_range)
+);
+GRANT SELECT,DELETE,UPDATE,INSERT ON known_devices TO accounts_service;
+
+CREATE TABLE sign_in_history
+(
+    id bigserial NOT NULL,
+    account_id uuid NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    attempted_at timestamptz NOT NULL DEFAULT now(),
+    client_ip text NOT NULL,
+    machine_id text NOT NULL,
+    user_agent text NOT NULL DEFAULT '',
+    succeeded boolean NOT NULL,
+    failure_reason text NOT NULL DEFAULT '',
+    CONSTRAINT sign_in_history_pkey PRIMARY KEY (id)
+);
+CREATE INDEX sign_in_history_account_id_idx ON sign_in_history (account_id, id DESC);
+CREATE INDEX sign_in_history_attempted_at_idx ON sign_in_history (attempted_at);
+GRANT SELECT,DELETE,UPDATE,INSERT ON sign_in_history TO accounts_service;
+GRANT USAGE ON SEQUENCE sign_in_history_id_seq TO accounts_service;
+
+-- The audit log is append-only and outlives the accounts, so there is no foreign key.
+CREATE TABLE audit_log
+(
+    id bigserial NOT NULL,
+    occurred_at timestamptz NOT NULL DEFAULT now(),
+    actor_id text NOT NULL DEFAULT '',
+    subject_account_id text NOT NULL DEFAULT '',
+    action text NOT NULL,
+    target text NOT NULL DEFAULT '',
+    client_ip text NOT NULL DEFAULT '',
+    request_id text NOT NULL DEFAULT '',
+    result text NOT NULL,
+    error text NOT NULL DEFAULT '',
+    CONSTRAINT audit_log_pkey PRIMARY KEY (id)
+);
+CREATE INDEX audit_log_actor_id_idx ON audit_log (actor_id, id DESC);
+CREATE INDEX audit_log_subject_account_id_idx ON audit_log (subject_account_id, id DESC);
+GRANT SELECT,INSERT ON audit_log TO accounts_service;
+GRANT USAGE ON SEQUENCE audit_log_id_seq TO accounts_service;
+
+CREATE TABLE events_outbox
+(
+    id bigserial NOT NULL,
+    topic text NOT NULL,
+    key text NOT NULL,
+    payload bytea NOT NULL,
+    headers jsonb NOT NULL DEFAULT '{}',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    sent_at timestamptz,
+    attempts integer NOT NULL DEFAULT 0,
+    last_error text NOT NULL DEFAULT '',
+    -- The time the message was given up after the maximum number of attempts, the relay skips it.
+    dead_lettered_at timestamptz,
+    CONSTRAINT events_outbox_pkey PRIMARY KEY (id)
+);
+CREATE INDEX events_outbox_unsent_idx ON events_outbox (id) WHERE sent_at IS NULL AND dead_lettered_at IS NULL;
+CREATE INDEX events_outbox_sent_at_idx ON events_outbox (sent_at) WHERE sent_at IS NOT NULL;
+GRANT SELECT,DELETE,UPDATE,INSERT ON events_outbox TO accounts_service;
+GRANT USAGE ON SEQUENCE events_outbox_id_seq TO accounts_service;
\ No newline at end of file
@@ -0,0 +1,38 @@
+-- Replaces the case-sensitive unique constraint of the accounts emails with the case-insensitive unique index
+-- and lowercases the domains of the stored emails.
+-- The migration fails without changes, if there are accounts, which emails differ only in case,
+-- the duplicates must be resolved manually, they are listed by the query:
+--
+-- SELECT lower(email) AS normalized_email, array_agg(id ORDER BY registration_date) AS accounts_ids
+-- FROM accounts GROUP BY lower(email) HAVING count(*) > 1;
+--
+-- The internationalized domains can't be converted to ASCII in SQL, such accounts are found
+-- by the case-insensitive comparison only if they were registered with the ASCII domain.
+BEGIN;
+
+DO $$
+DECLARE
+    duplicates text;
+BEGIN
+    SELECT string_agg(normalized_email || ' (' || accounts_count || ' accounts)', ', ')
+    INTO duplicates
+    FROM (
+        SELECT lower(trim(email)) AS normalized_email, count(*) AS accounts_count
+        FROM accounts
+        GROUP BY lower(trim(email))
+        HAVING count(*) > 1
+    ) AS duplicated;
+
+    IF duplicates IS NOT NULL THEN
+        RAISE EXCEPTION 'accounts with case-insensitively equal emails found: %', duplicates;
+    END IF;
+END $$;
+
+UPDATE accounts
+SET email = substring(trim(email) FROM '^(.*@)') || lower(substring(trim(email) FROM '@([^@]*)$'))
+WHERE email <> substring(trim(email) FROM '^(.*@)') || lower(substring(trim(email) FROM '@([^@]*)$'));
+
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS accounts_email_key;
+CREATE UNIQUE INDEX IF NOT EXISTS accounts_email_lower_idx ON accounts (lower(email));
+
+COMMIT;
@@ -0,0 +1,20 @@
+-- Creates the table of the registration invitations.
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS invitations
+(
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    code_hash text NOT NULL UNIQUE,
+    -- The email the invitation is bound to, empty if any email can be registered with the invitation.
+    email text NOT NULL DEFAULT '',
+    max_uses integer NOT NULL,
+    uses integer NOT NULL DEFAULT 0,
+    created_by uuid REFERENCES accounts(id) ON DELETE SET NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    expires_at timestamptz,
+    CONSTRAINT invitation_id_pkey PRIMARY KEY (id),
+    CONSTRAINT invitation_uses_check CHECK (uses <= max_uses)
+);
+GRANT SELECT,DELETE,UPDATE,INSERT ON invitations TO accounts_service;
+
+COMMIT;
@@ -0,0 +1,22 @@
+-- Creates the transactional outbox of the account events, the relay publishes the messages to the broker.
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS events_outbox
+(
+    id bigserial NOT NULL,
+    topic text NOT NULL,
+    key text NOT NULL,
+    payload bytea NOT NULL,
+    headers jsonb NOT NULL DEFAULT '{}',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    sent_at timestamptz,
+    attempts integer NOT NULL DEFAULT 0,
+    last_error text NOT NULL DEFAULT '',
+    CONSTRAINT events_outbox_pkey PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS events_outbox_unsent_idx ON events_outbox (id) WHERE sent_at IS NULL;
+CREATE INDEX IF NOT EXISTS events_outbox_sent_at_idx ON events_outbox (sent_at) WHERE sent_at IS NOT NULL;
+GRANT SELECT,DELETE,UPDATE,INSERT ON events_outbox TO accounts_service;
+GRANT USAGE ON SEQUENCE events_outbox_id_seq TO accounts_service;
+
+COMMIT;
@@ -0,0 +1,9 @@
+-- Adds the dead-lettering of the outbox messages, which the broker fails to accept.
+BEGIN;
+
+ALTER TABLE events_outbox ADD COLUMN IF NOT EXISTS dead_lettered_at timestamptz;
+
+DROP INDEX IF EXISTS events_outbox_unsent_idx;
+CREATE INDEX events_outbox_unsent_idx ON events_outbox (id) WHERE sent_at IS NULL AND dead_lettered_at IS NULL;
+
+COMMIT;
@@ -0,0 +1,19 @@
+-- Creates the table of the personal access tokens.
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS access_tokens
+(
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    account_id uuid NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    name text NOT NULL,
+    token_hash text NOT NULL UNIQUE,
+    scopes text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    expires_at timestamptz,
+    last_used_at timestamptz,
+    CONSTRAINT access_token_id_pkey PRIMARY KEY (id),
+    CONSTRAINT access_token_account_name_unique UNIQUE (account_id, name)
+);
+GRANT SELECT,DELETE,UPDATE,INSERT ON access_tokens TO accounts_service;
+
+COMMIT;
@@ -0,0 +1,27 @@
+-- Adds the administrators and creates the tables of the service accounts and their client credentials.
+BEGIN;
+
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS is_admin boolean NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS service_accounts
+(
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    owner_id uuid NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    name text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT service_account_id_pkey PRIMARY KEY (id),
+    CONSTRAINT service_account_owner_name_unique UNIQUE (owner_id, name)
+);
+GRANT SELECT,DELETE,UPDATE,INSERT ON service_accounts TO accounts_service;
+
+CREATE TABLE IF NOT EXISTS service_account_credentials
+(
+    client_id text NOT NULL,
+    service_account_id uuid NOT NULL REFERENCES service_accounts(id) ON DELETE CASCADE,
+    secret_hash text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT service_account_credentials_pkey PRIMARY KEY (client_id)
+);
+GRANT SELECT,DELETE,UPDATE,INSERT ON service_account_credentials TO accounts_service;
+
+COMMIT;
@@ -0,0 +1,15 @@
+-- Creates the table of the known devices, the sign ins from the other devices are reported.
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS known_devices
+(
+    account_id uuid NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    machine_id text NOT NULL,
+    ip_range cidr NOT NULL,
+    first_seen_at timestamptz NOT NULL DEFAULT now(),
+    last_seen_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT known_device_pkey PRIMARY KEY (account_id, machine_id, ip_range)
+);
+GRANT SELECT,DELETE,UPDATE,INSERT ON known_devices TO accounts_service;
+
+COMMIT;
@@ -0,0 +1,21 @@
+-- Creates the table of the sign in attempts.
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS sign_in_history
+(
+    id bigserial NOT NULL,
+    account_id uuid NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    attempted_at timestamptz NOT NULL DEFAULT now(),
+    client_ip text NOT NULL,
+    machine_id text NOT NULL,
+    user_agent text NOT NULL DEFAULT '',
+    succeeded boolean NOT NULL,
+    failure_reason text NOT NULL DEFAULT '',
+    CONSTRAINT sign_in_history_pkey PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS sign_in_history_account_id_idx ON sign_in_history (account_id, id DESC);
+CREATE INDEX IF NOT EXISTS sign_in_history_attempted_at_idx ON sign_in_history (attempted_at);
+GRANT SELECT,DELETE,UPDATE,INSERT ON sign_in_history TO accounts_service;
+GRANT USAGE ON SEQUENCE sign_in_history_id_seq TO accounts_service;
+
+COMMIT;
@@ -0,0 +1,24 @@
+-- Creates the table of the security audit log.
+-- The audit log is append-only and outlives the accounts, so there is no foreign key.
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS audit_log
+(
+    id bigserial NOT NULL,
+    occurred_at timestamptz NOT NULL DEFAULT now(),
+    actor_id text NOT NULL DEFAULT '',
+    subject_account_id text NOT NULL DEFAULT '',
+    action text NOT NULL,
+    target text NOT NULL DEFAULT '',
+    client_ip text NOT NULL DEFAULT '',
+    request_id text NOT NULL DEFAULT '',
+    result text NOT NULL,
+    error text NOT NULL DEFAULT '',
+    CONSTRAINT audit_log_pkey PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS audit_log_actor_id_idx ON audit_log (actor_id, id DESC);
+CREATE INDEX IF NOT EXISTS audit_log_subject_account_id_idx ON audit_log (subject_account_id, id DESC);
+GRANT SELECT,INSERT ON audit_log TO accounts_service;
+GRANT USAGE ON SEQUENCE audit_log_id_seq TO accounts_service;
+
+COMMIT;
@@ -0,0 +1,7 @@
+-- Adds the scheduled deletion of the accounts, the accounts are purged after the grace period.
+BEGIN;
+
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS purge_at timestamptz;
+CREATE INDEX IF NOT EXISTS accounts_purge_at_idx ON accounts (purge_at) WHERE purge_at IS NOT NULL;
+
+COMMIT;
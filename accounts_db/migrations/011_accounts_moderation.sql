@@ -0,0 +1,8 @@
+-- Adds the suspension of the accounts by the moderation and the undeliverable emails flag.
+BEGIN;
+
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS suspended_at timestamptz;
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS suspension_reason text NOT NULL DEFAULT '';
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS email_undeliverable boolean NOT NULL DEFAULT false;
+
+COMMIT;
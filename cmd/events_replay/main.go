@@ -0,0 +1,158 @@
+// The events_replay command republishes the account_created events of the active accounts,
+// so a new consumer service is able to backfill the accounts. The events are marked with the ce_replay header.
+// The accounts scheduled for deletion and the suspended accounts aren't replayed, so they aren't restored downstream.
+// The username isn't stored by the service, so the replayed events have an empty username, unlike the live events.
+//
+// The id of the last replayed account is stored in the checkpoint file after each batch,
+// the interrupted replay is resumed from the checkpoint. The checkpoint is removed when the replay is completed.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/config"
+	"github.com/Falokut/accounts_service/internal/events"
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/Falokut/accounts_service/internal/repository"
+	"github.com/Falokut/accounts_service/internal/repository/postgresrepository"
+	"github.com/Falokut/accounts_service/pkg/logging"
+	"github.com/sirupsen/logrus"
+)
+
+func main() {
+	rate := flag.Float64("rate", 100, "the maximum number of the events published per second")
+	batchSize := flag.Int("batch", 500, "the number of the accounts read from the database at once")
+	checkpointPath := flag.String("checkpoint", "events_replay.checkpoint", "the path to the checkpoint file")
+	restart := flag.Bool("restart", false, "ignore the checkpoint and replay all accounts")
+	flag.Parse()
+
+	logging.NewEntry(logging.ConsoleOutput)
+	logger := logging.GetLogger()
+	cfg := config.GetConfig()
+
+	if *rate <= 0 || *batchSize <= 0 {
+		logger.Fatal("rate and batch must be positive")
+	}
+
+	database, err := postgresrepository.NewPostgreDB(&cfg.DBConfig)
+	if err != nil {
+		logger.Fatalf("connection to the database is not established: %s", err.Error())
+	}
+	repo := postgresrepository.NewAccountsRepository(database, logger.Logger)
+	defer repo.Shutdown()
+
+	eventsEncoder, err := events.NewEventsEncoder(cfg.EventsEncoding)
+	if err != nil {
+		logger.Errorf("invalid events encoding config: %s", err.Error())
+		return
+	}
+	broker, err := events.NewBroker(cfg.AccountEventsConfig, logger.Logger)
+	if err != nil {
+		logger.Errorf("error while creating accounts events broker: %s", err.Error())
+		return
+	}
+	accountsEventsMQ := events.NewAccountsEvents(broker, eventsEncoder, logger.Logger)
+	defer accountsEventsMQ.Shutdown()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	r := &replayer{
+		repo:           repo,
+		events:         accountsEventsMQ,
+		logger:         logger.Logger,
+		interval:       time.Duration(float64(time.Second) / *rate),
+		batchSize:      *batchSize,
+		checkpointPath: *checkpointPath,
+	}
+	if err = r.replay(ctx, *restart); err != nil {
+		logger.Errorf("replay stopped, run the command again to resume, error: %s", err.Error())
+	}
+}
+
+type replayer struct {
+	repo           repository.AccountRepository
+	events         events.AccountsEventsMQ
+	logger         *logrus.Logger
+	interval       time.Duration
+	batchSize      int
+	checkpointPath string
+}
+
+func (r *replayer) replay(ctx context.Context, restart bool) error {
+	var afterID string
+	if !restart {
+		checkpoint, err := os.ReadFile(r.checkpointPath)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		afterID = strings.TrimSpace(string(checkpoint))
+		if afterID != "" {
+			r.logger.Infof("resuming replay after the account %s", afterID)
+		}
+	}
+
+	limiter := time.NewTicker(r.interval)
+	defer limiter.Stop()
+
+	var replayed int
+	for {
+		accounts, err := r.repo.GetActiveAccounts(ctx, afterID, r.batchSize)
+		if err != nil {
+			return err
+		}
+		if len(accounts) == 0 {
+			break
+		}
+
+		for i := range accounts {
+			select {
+			case <-ctx.Done():
+				return errors.Join(ctx.Err(), r.saveCheckpoint(afterID))
+			case <-limiter.C:
+			}
+
+			// The username isn't stored by the service, so it's left empty.
+			err = r.events.ReplayAccountCreated(ctx, models.AccountCreatedDTO{
+				ID:               accounts[i].ID,
+				Email:            accounts[i].Email,
+				RegistrationDate: accounts[i].RegistrationDate,
+			})
+			if err != nil {
+				return errors.Join(err, r.saveCheckpoint(afterID))
+			}
+			afterID = accounts[i].ID
+			replayed++
+		}
+
+		if err = r.saveCheckpoint(afterID); err != nil {
+			return err
+		}
+		r.logger.Infof("%d account_created events replayed", replayed)
+	}
+
+	r.logger.Infof("replay completed, %d account_created events replayed", replayed)
+	if err := os.Remove(r.checkpointPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// saveCheckpoint writes the id of the last replayed account to the temporary file and renames it,
+// so the checkpoint is never partially written.
+func (r *replayer) saveCheckpoint(afterID string) error {
+	if afterID == "" {
+		return nil
+	}
+	if err := os.WriteFile(r.checkpointPath+".tmp", []byte(afterID), 0o600); err != nil {
+		return err
+	}
+	return os.Rename(r.checkpointPath+".tmp", r.checkpointPath)
+}
@@ -3,12 +3,17 @@ package main
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 
+	"github.com/Falokut/accounts_service/internal/challenge"
 	"github.com/Falokut/accounts_service/internal/config"
+	"github.com/Falokut/accounts_service/internal/domainpolicy"
 	"github.com/Falokut/accounts_service/internal/events"
+	"github.com/Falokut/accounts_service/internal/geolocation"
 	"github.com/Falokut/accounts_service/internal/handler"
 	"github.com/Falokut/accounts_service/internal/repository/postgresrepository"
 	"github.com/Falokut/accounts_service/internal/repository/redisrepository"
@@ -37,6 +42,21 @@ func main() {
 	}
 	logger.Logger.SetLevel(logLevel)
 
+	switch service.SessionsLimitAction(cfg.SessionsLimit.OnLimitExceeded) {
+	case service.RejectSessionsLimitAction, service.EvictSessionsLimitAction:
+	default:
+		logger.Fatalf("invalid sessions limit action %q, expected reject or evict", cfg.SessionsLimit.OnLimitExceeded)
+	}
+
+	switch service.RegistrationMode(cfg.Registration.Mode) {
+	case service.OpenRegistrationMode, service.InviteOnlyRegistrationMode:
+	default:
+		logger.Fatalf("invalid registration mode %q, expected open or invite_only", cfg.Registration.Mode)
+	}
+	if err := checkTokensSecrets(cfg); err != nil {
+		logger.Fatal(err)
+	}
+
 	tracer, closer, err := jaegerTracer.InitJaeger(cfg.JaegerConfig)
 	if err != nil {
 		logger.Errorf("Shutting down, error while creating tracer %v", err)
@@ -95,6 +115,22 @@ func main() {
 	}
 	defer sessionsRepository.Shutdown()
 
+	logger.Info("Data exports cache initializing")
+	dataExportsRepository, err := redisrepository.NewDataExportsRepository(
+		&redis.Options{
+			Network:  cfg.DataExportsRepositoryConfig.Network,
+			Addr:     cfg.DataExportsRepositoryConfig.Addr,
+			Password: cfg.DataExportsRepositoryConfig.Password,
+			DB:       cfg.DataExportsRepositoryConfig.DB,
+		},
+		logger.Logger, metric)
+	if err != nil {
+		logger.Errorf("Shutting down, connection to the redis data exports repository is not established: %s",
+			err.Error())
+		return
+	}
+	defer dataExportsRepository.Shutdown()
+
 	logger.Info("Database initializing")
 	database, err := postgresrepository.NewPostgreDB(&cfg.DBConfig)
 	if err != nil {
@@ -105,16 +141,97 @@ func main() {
 	logger.Info("Repository initializing")
 	repo := postgresrepository.NewAccountsRepository(database, logger.Logger)
 	defer repo.Shutdown()
+	accessTokensRepository := postgresrepository.NewAccessTokensRepository(database, logger.Logger)
+	serviceAccountsRepository := postgresrepository.NewServiceAccountsRepository(database, logger.Logger)
+	knownDevicesRepository := postgresrepository.NewKnownDevicesRepository(database, logger.Logger)
+	signInHistoryRepository := postgresrepository.NewSignInHistoryRepository(database, logger.Logger)
+	auditLogRepository := postgresrepository.NewAuditLogRepository(database, logger.Logger)
+	invitationsRepository := postgresrepository.NewInvitationsRepository(database, logger.Logger)
+	outboxRepository := postgresrepository.NewOutboxRepository(database, logger.Logger)
 
-	accountsEventsMQ := events.NewAccountsEvents(events.KafkaConfig{
-		Brokers: cfg.AccountEventsConfig.Brokers,
-	}, logger.Logger)
-	defer accountsEventsMQ.Shutdown()
+	eventsEncoder, err := events.NewEventsEncoder(cfg.EventsEncoding)
+	if err != nil {
+		logger.Errorf("Shutting down, invalid events encoding config: %s", err.Error())
+		return
+	}
+
+	// The background goroutines use the brokers, the outbox relay and the consumer, so they are stopped
+	// before any of them is closed. The deferred calls run in the reverse order, so each deferred close
+	// stops the background goroutines first.
+	backgroundCtx, cancelBackground := context.WithCancel(context.Background())
+	var background sync.WaitGroup
+	runInBackground := func(run func(ctx context.Context)) {
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			run(backgroundCtx)
+		}()
+	}
+	stopBackground := sync.OnceFunc(func() {
+		cancelBackground()
+		background.Wait()
+	})
+	defer stopBackground()
+
+	logger.Info("Events brokers initializing")
+	accountsEventsBroker, err := newEventsBroker(runInBackground, cfg.AccountEventsConfig,
+		"accounts_events", metric, logger.Logger)
+	if err != nil {
+		logger.Errorf("Shutting down, error while creating accounts events broker: %s", err.Error())
+		return
+	}
+	accountsEventsMQ := events.NewAccountsEvents(accountsEventsBroker, eventsEncoder, logger.Logger)
+	defer func() {
+		stopBackground()
+		accountsEventsMQ.Shutdown()
+	}()
+	accountsEventsOutbox := events.NewAccountsEventsOutbox(outboxRepository, eventsEncoder, logger.Logger)
 
-	tokenDeliveryMQ := events.NewTokensDeliveryMQ(events.KafkaConfig{
-		Brokers: cfg.TokensDeliveryConfig.Brokers,
+	outboxBroker, err := events.NewBroker(cfg.AccountEventsConfig, logger.Logger)
+	if err != nil {
+		logger.Errorf("Shutting down, error while creating outbox relay broker: %s", err.Error())
+		return
+	}
+	outboxRelay := events.NewOutboxRelay(outboxRepository, outboxBroker, events.OutboxRelayConfig{
+		Interval:      cfg.OutboxConfig.RelayInterval,
+		BatchSize:     cfg.OutboxConfig.BatchSize,
+		SentRetention: cfg.OutboxConfig.SentRetention,
+		MaxAttempts:   cfg.OutboxConfig.MaxAttempts,
 	}, logger.Logger)
-	defer accountsEventsMQ.Shutdown()
+	defer func() {
+		stopBackground()
+		outboxRelay.Shutdown()
+	}()
+
+	tokenDeliveryMQ, err := newTokensDelivery(runInBackground, cfg.TokensDeliveryConfig, metric, logger.Logger)
+	if err != nil {
+		logger.Errorf("Shutting down, error while creating tokens delivery: %s", err.Error())
+		return
+	}
+	defer func() {
+		stopBackground()
+		tokenDeliveryMQ.Shutdown()
+	}()
+
+	logger.Info("Geolocation initializing")
+	locator, err := geolocation.NewLocator(cfg.GeolocationConfig, logger.Logger)
+	if err != nil {
+		logger.Errorf("Shutting down, error while opening geolocation database: %s", err.Error())
+		return
+	}
+	defer locator.Shutdown()
+
+	domainPolicy, err := domainpolicy.NewPolicy(cfg.DomainPolicy, logger.Logger)
+	if err != nil {
+		logger.Errorf("Shutting down, error while loading email domain policy: %s", err.Error())
+		return
+	}
+
+	challengeGuard, err := challenge.NewGuard(cfg.Challenge, logger.Logger)
+	if err != nil {
+		logger.Errorf("Shutting down, error while creating challenge guard: %s", err.Error())
+		return
+	}
 
 	go func() {
 		logger.Info("Healthcheck initializing")
@@ -129,10 +246,45 @@ func main() {
 
 	logger.Info("Service initializing")
 	s := service.NewAccountsService(repo,
-		logger.Logger, registrationRepository, sessionsRepository, accountsEventsMQ, tokenDeliveryMQ,
+		logger.Logger, registrationRepository, sessionsRepository, accessTokensRepository,
+		serviceAccountsRepository, knownDevicesRepository, signInHistoryRepository, auditLogRepository,
+		dataExportsRepository, invitationsRepository,
+		accountsEventsMQ, accountsEventsOutbox, tokenDeliveryMQ, locator, domainPolicy,
 		getAccountServiceConfig(cfg))
 
-	h := handler.NewAccountsServiceHandler(logger.Logger, s)
+	trustedProxies, err := handler.ParseTrustedProxies(cfg.Listen.TrustedProxies)
+	if err != nil {
+		logger.Errorf("Shutting down, error while parsing trusted proxies: %s", err.Error())
+		return
+	}
+	h := handler.NewAccountsServiceHandler(logger.Logger, s, challengeGuard, trustedProxies)
+
+	var consumer *events.InboundConsumer
+	if len(cfg.InboundEventsConfig.Brokers) > 0 {
+		logger.Info("Inbound events consumer initializing")
+		consumer, err = events.NewInboundConsumer(cfg.InboundEventsConfig, s, logger.Logger)
+		if err != nil {
+			logger.Errorf("Shutting down, error while creating inbound events consumer: %s", err.Error())
+			return
+		}
+		defer func() {
+			stopBackground()
+			consumer.Shutdown()
+		}()
+	}
+
+	pruner := service.NewSignInHistoryPruner(signInHistoryRepository, logger.Logger,
+		cfg.SignInHistory.Retention, cfg.SignInHistory.PruneInterval)
+	runInBackground(domainPolicy.Run)
+	runInBackground(challengeGuard.Run)
+	runInBackground(pruner.Run)
+	purger := service.NewAccountsPurger(s, logger.Logger, cfg.AccountDeletion.PurgeInterval)
+	runInBackground(purger.Run)
+	runInBackground(service.NewDataExportWorker(s, logger.Logger).Run)
+	if consumer != nil {
+		runInBackground(consumer.Run)
+	}
+	runInBackground(outboxRelay.Run)
 
 	logger.Info("Server initializing")
 	serv := server.NewServer(logger.Logger, h)
@@ -187,5 +339,93 @@ func getAccountServiceConfig(cfg *config.Config) *service.AccountsServiceConfig
 		NonActivatedAccountTTL:             cfg.NonActivatedAccountTTL,
 		BcryptCost:                         cfg.Crypto.BcryptCost,
 		SessionTTL:                         cfg.SessionsTTL,
+		RememberMeSessionTTL:               cfg.RememberMeSessionsTTL,
+		SessionMaxLifetime:                 cfg.SessionsMaxLifetime,
+		AccessTokenMaxTTL:                  cfg.AccessTokens.MaxTTL,
+		MaxAccessTokensPerAccount:          cfg.AccessTokens.MaxTokensPerAccount,
+		ServiceAccountTokenTTL:             cfg.JWT.ServiceAccountToken.TTL,
+		ServiceAccountTokenSecret:          cfg.JWT.ServiceAccountToken.Secret,
+		AccountDeletionGracePeriod:         cfg.AccountDeletion.GracePeriod,
+		RestoreAccountTokenTTL:             cfg.JWT.RestoreAccountToken.TTL,
+		RestoreAccountTokenSecret:          cfg.JWT.RestoreAccountToken.Secret,
+		RecentAuthenticationWindow:         cfg.RecentAuthenticationWindow,
+		PasswordVerification: service.PasswordVerificationConfig{
+			MaxFailedAttempts: cfg.PasswordVerification.MaxFailedAttempts,
+			LockoutWindow:     cfg.PasswordVerification.LockoutWindow,
+		},
+		DataExportTTL:         cfg.JWT.DataExportToken.TTL,
+		DataExportTokenSecret: cfg.JWT.DataExportToken.Secret,
+		DataExportCooldown:    cfg.DataExportCooldown,
+		RegistrationMode:      service.RegistrationMode(cfg.Registration.Mode),
+		SessionsLimit: service.SessionsLimitConfig{
+			MaxPerAccount:    cfg.SessionsLimit.MaxPerAccount,
+			MaxPerClientType: cfg.SessionsLimit.MaxPerClientType,
+			Action:           service.SessionsLimitAction(cfg.SessionsLimit.OnLimitExceeded),
+		},
+	}
+}
+
+// checkTokensSecrets checks that the secrets of the signed tokens are specified,
+// the tokens signed with the empty key can be forged by anyone.
+func checkTokensSecrets(cfg *config.Config) error {
+	secrets := []struct {
+		env    string
+		secret string
+	}{
+		{"CHANGE_PASSWORD_TOKEN_SECRET", cfg.JWT.ChangePasswordToken.Secret},
+		{"VERIFY_ACCOUNT_TOKEN_SECRET", cfg.JWT.VerifyAccountToken.Secret},
+		{"SERVICE_ACCOUNT_TOKEN_SECRET", cfg.JWT.ServiceAccountToken.Secret},
+		{"RESTORE_ACCOUNT_TOKEN_SECRET", cfg.JWT.RestoreAccountToken.Secret},
+		{"DATA_EXPORT_TOKEN_SECRET", cfg.JWT.DataExportToken.Secret},
+	}
+	for _, s := range secrets {
+		if s.secret == "" {
+			return fmt.Errorf("token secret isn't specified, set %s", s.env)
+		}
+	}
+	return nil
+}
+
+// newEventsBroker creates the broker and wraps it with the spool, if the spool is configured.
+func newEventsBroker(runInBackground func(run func(ctx context.Context)), cfg events.BrokerConfig, name string,
+	metric metrics.Metrics, logger *logrus.Logger) (events.Broker, error) {
+	broker, err := events.NewBroker(cfg, logger)
+	if err != nil || cfg.Spool.Dir == "" {
+		return broker, err
+	}
+
+	spool, err := events.NewSpoolBroker(broker, name, cfg.Spool, metric, logger)
+	if err != nil {
+		broker.Close()
+		return nil, err
+	}
+	runInBackground(spool.Run)
+	return spool, nil
+}
+
+type tokensDelivery interface {
+	events.TokensDeliveryMQ
+	Shutdown()
+}
+
+// newTokensDelivery creates the tokens delivery of the configured backend.
+func newTokensDelivery(runInBackground func(run func(ctx context.Context)), cfg events.TokensDeliveryConfig,
+	metric metrics.Metrics, logger *logrus.Logger) (tokensDelivery, error) {
+	switch cfg.Backend {
+	case events.SMTPTokensDelivery:
+		delivery, err := events.NewSMTPTokensDelivery(cfg.SMTP, logger)
+		if err != nil {
+			return nil, err
+		}
+		runInBackground(delivery.Run)
+		return delivery, nil
+	case events.BrokerTokensDelivery, "":
+		broker, err := newEventsBroker(runInBackground, cfg.Broker, "tokens_delivery", metric, logger)
+		if err != nil {
+			return nil, err
+		}
+		return events.NewTokensDeliveryMQ(broker, logger), nil
+	default:
+		return nil, fmt.Errorf("unsupported tokens delivery backend %q", cfg.Backend)
 	}
 }
@@ -0,0 +1,91 @@
+package challenge
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// activityTracker counts the events of the client ips within the fixed windows.
+// The number of the tracked clients is limited, when the limit is reached, the new clients
+// aren't tracked and are reported as exceeding any threshold, so the flood of the addresses
+// can't exhaust the memory or bypass the challenge.
+type activityTracker struct {
+	window time.Duration
+	// unlimited if not positive
+	maxClients int
+
+	mu      sync.Mutex
+	clients map[string]*clientActivity
+}
+
+type clientActivity struct {
+	count       int
+	windowStart time.Time
+}
+
+func newActivityTracker(window time.Duration, maxClients int) *activityTracker {
+	return &activityTracker{window: window, maxClients: maxClients, clients: make(map[string]*clientActivity)}
+}
+
+// add records the event of the client and returns the number of its events in the current window.
+func (t *activityTracker) add(clientIP string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	activity, ok := t.clients[clientIP]
+	if !ok && t.isFull() {
+		t.removeExpired(now)
+		if t.isFull() {
+			return math.MaxInt
+		}
+	}
+	if !ok || now.Sub(activity.windowStart) >= t.window {
+		activity = &clientActivity{windowStart: now}
+		t.clients[clientIP] = activity
+	}
+	activity.count++
+	return activity.count
+}
+
+// count returns the number of the events of the client in the current window.
+func (t *activityTracker) count(clientIP string) int {
+	if t == nil {
+		return 0
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	activity, ok := t.clients[clientIP]
+	if !ok && t.isFull() {
+		return math.MaxInt
+	}
+	if !ok || time.Since(activity.windowStart) >= t.window {
+		return 0
+	}
+	return activity.count
+}
+
+func (t *activityTracker) isFull() bool {
+	return t.maxClients > 0 && len(t.clients) >= t.maxClients
+}
+
+// cleanup removes the clients, which window has expired.
+func (t *activityTracker) cleanup() {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.removeExpired(time.Now())
+}
+
+func (t *activityTracker) removeExpired(now time.Time) {
+	for clientIP, activity := range t.clients {
+		if now.Sub(activity.windowStart) >= t.window {
+			delete(t.clients, clientIP)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+type CaptchaConfig struct {
+	// The verification endpoint of the CAPTCHA service, compatible with reCAPTCHA, hCaptcha and Turnstile,
+	// e.g. https://hcaptcha.com/siteverify
+	VerifyURL string `yaml:"verify_url"`
+	// The public key of the CAPTCHA widget, it's returned to the clients
+	SiteKey string        `yaml:"site_key"`
+	Secret  string        `yaml:"secret" env:"CAPTCHA_SECRET"`
+	Timeout time.Duration `yaml:"timeout" env-default:"5s"`
+}
+
+// captchaVerifier verifies the CAPTCHA response token with the verification endpoint.
+type captchaVerifier struct {
+	cfg    CaptchaConfig
+	client *http.Client
+	logger *logrus.Logger
+}
+
+func NewCaptchaVerifier(cfg CaptchaConfig, logger *logrus.Logger) (*captchaVerifier, error) {
+	if cfg.VerifyURL == "" {
+		return nil, errors.New("captcha verify url isn't specified")
+	}
+
+	return &captchaVerifier{
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+		logger: logger,
+	}, nil
+}
+
+func (v *captchaVerifier) NewChallenge(_ string) (Challenge, error) {
+	return Challenge{Type: CaptchaType, SiteKey: v.cfg.SiteKey}, nil
+}
+
+type captchaVerifyResponse struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+func (v *captchaVerifier) Verify(ctx context.Context, clientIP, solution string) error {
+	form := url.Values{
+		"secret":   {v.cfg.Secret},
+		"response": {solution},
+	}
+	if clientIP != "" {
+		form.Set("remoteip", clientIP)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.cfg.VerifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return models.Error(models.Internal, err.Error())
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		v.logger.Error("captcha not verified, error: ", err.Error())
+		return models.Error(models.Internal, "captcha verification is unavailable")
+	}
+	defer resp.Body.Close()
+
+	var result captchaVerifyResponse
+	if resp.StatusCode != http.StatusOK {
+		v.logger.Errorf("captcha not verified, unexpected status %d", resp.StatusCode)
+		return models.Error(models.Internal, "captcha verification is unavailable")
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		v.logger.Error("captcha verification response not decoded, error: ", err.Error())
+		return models.Error(models.Internal, "captcha verification is unavailable")
+	}
+
+	if !result.Success {
+		v.logger.Debugf("captcha rejected, error codes: %v", result.ErrorCodes)
+		return models.Error(models.PermissionDenied, "invalid captcha response")
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package challenge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+// Mode defines when the clients must solve the challenge.
+type Mode string
+
+const (
+	// OffMode disables the challenges.
+	OffMode Mode = "off"
+	// AlwaysMode requires the challenge on every protected request.
+	AlwaysMode Mode = "always"
+	// AdaptiveMode requires the challenge only from the clients with the suspicious activity.
+	AdaptiveMode Mode = "adaptive"
+)
+
+const (
+	ProofOfWorkType = "pow"
+	CaptchaType     = "captcha"
+)
+
+type Config struct {
+	// When the challenge is required: off, always or adaptive
+	Mode Mode `yaml:"mode" env-default:"off"`
+	// The challenge: pow (the built-in hashcash proof of work) or captcha (the external CAPTCHA service)
+	Type        string            `yaml:"type" env-default:"pow"`
+	Adaptive    AdaptiveConfig    `yaml:"adaptive"`
+	ProofOfWork ProofOfWorkConfig `yaml:"proof_of_work"`
+	Captcha     CaptchaConfig     `yaml:"captcha"`
+}
+
+type AdaptiveConfig struct {
+	// The number of the protected requests and the failed sign in attempts from the client ip within the window,
+	// after which the challenge is required
+	Threshold int           `yaml:"threshold" env-default:"10"`
+	Window    time.Duration `yaml:"window" env-default:"10m"`
+	// The maximum number of the tracked client ips, the challenge is required from the untracked clients
+	// while the limit is reached, unlimited if negative
+	MaxClients int `yaml:"max_clients" env-default:"100000"`
+}
+
+// Challenge describes the challenge the client must solve before the protected request.
+type Challenge struct {
+	Required bool
+	Type     string
+	// The challenge of the proof of work, empty for the captcha
+	Value string
+	// The number of the leading zero bits of the proof of work hash
+	Difficulty int
+	// The public key of the captcha widget, empty for the proof of work
+	SiteKey   string
+	ExpiresAt time.Time
+}
+
+// Verifier issues the challenges and verifies their solutions.
+type Verifier interface {
+	NewChallenge(clientIP string) (Challenge, error)
+	Verify(ctx context.Context, clientIP, solution string) error
+}
+
+// Guard requires the challenge solution on the protected requests, depending on the mode.
+// The activity is tracked by each replica separately.
+type Guard struct {
+	cfg      Config
+	verifier Verifier
+	activity *activityTracker
+	logger   *logrus.Logger
+}
+
+func NewGuard(cfg Config, logger *logrus.Logger) (*Guard, error) {
+	g := &Guard{cfg: cfg, logger: logger}
+	switch cfg.Mode {
+	case OffMode:
+		return g, nil
+	case AlwaysMode, AdaptiveMode:
+	default:
+		return nil, fmt.Errorf("invalid challenge mode %q, expected off, always or adaptive", cfg.Mode)
+	}
+
+	var err error
+	switch cfg.Type {
+	case ProofOfWorkType:
+		g.verifier, err = NewProofOfWorkVerifier(cfg.ProofOfWork, logger)
+	case CaptchaType:
+		g.verifier, err = NewCaptchaVerifier(cfg.Captcha, logger)
+	default:
+		err = fmt.Errorf("invalid challenge type %q, expected pow or captcha", cfg.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Mode == AdaptiveMode {
+		g.activity = newActivityTracker(cfg.Adaptive.Window, cfg.Adaptive.MaxClients)
+	}
+	return g, nil
+}
+
+// GetChallenge returns the challenge for the client, the challenge is issued even if it isn't required yet.
+func (g *Guard) GetChallenge(clientIP string) (Challenge, error) {
+	if g.cfg.Mode == OffMode {
+		return Challenge{}, nil
+	}
+
+	challenge, err := g.verifier.NewChallenge(clientIP)
+	if err != nil {
+		return Challenge{}, models.Error(models.Internal, "can't issue challenge")
+	}
+	challenge.Required = g.cfg.Mode == AlwaysMode ||
+		g.activity.count(clientIP) >= g.cfg.Adaptive.Threshold
+	return challenge, nil
+}
+
+// Check records the protected request of the client and verifies the solution, if the challenge is required.
+func (g *Guard) Check(ctx context.Context, clientIP, solution string) error {
+	switch g.cfg.Mode {
+	case OffMode:
+		return nil
+	case AdaptiveMode:
+		if g.activity.add(clientIP) <= g.cfg.Adaptive.Threshold {
+			return nil
+		}
+	}
+
+	if solution == "" {
+		return models.Error(models.FailedPrecondition,
+			"challenge is required, get it with GetChallenge and pass the solution in the X-Challenge-Response header")
+	}
+	return g.verifier.Verify(ctx, clientIP, solution)
+}
+
+// RecordFailure records the failed attempt of the client, e.g. the sign in with the invalid password.
+func (g *Guard) RecordFailure(clientIP string) {
+	if g.cfg.Mode == AdaptiveMode {
+		g.activity.add(clientIP)
+	}
+}
+
+// Run removes the expired activity and the used challenges until the context is canceled.
+func (g *Guard) Run(ctx context.Context) {
+	if g.cfg.Mode == OffMode {
+		return
+	}
+
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			g.activity.cleanup()
+			if pow, ok := g.verifier.(*proofOfWorkVerifier); ok {
+				pow.cleanup()
+			}
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package challenge_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"io"
+	"math/bits"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/challenge"
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+func newLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return logger
+}
+
+func solve(value string, difficulty int) string {
+	for counter := 0; ; counter++ {
+		solution := value + ":" + strconv.Itoa(counter)
+		hash := sha256.Sum256([]byte(solution))
+		zeros := 0
+		for _, b := range hash {
+			zeros += bits.LeadingZeros8(b)
+			if b != 0 {
+				break
+			}
+		}
+		if zeros >= difficulty {
+			return solution
+		}
+	}
+}
+
+func TestProofOfWork(t *testing.T) {
+	guard, err := challenge.NewGuard(challenge.Config{
+		Mode: challenge.AlwaysMode,
+		Type: challenge.ProofOfWorkType,
+		ProofOfWork: challenge.ProofOfWorkConfig{
+			Difficulty: 8,
+			TTL:        time.Minute,
+			Secret:     "secret",
+		},
+	}, newLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	if err := guard.Check(ctx, "10.0.0.1", ""); models.Code(err) != models.FailedPrecondition {
+		t.Fatalf("expected failed precondition without the solution, got %v", err)
+	}
+
+	c, err := guard.GetChallenge("10.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.Required || c.Type != challenge.ProofOfWorkType || c.Difficulty != 8 {
+		t.Fatalf("unexpected challenge %+v", c)
+	}
+
+	solution := solve(c.Value, c.Difficulty)
+	if err := guard.Check(ctx, "10.0.0.2", solution); models.Code(err) != models.PermissionDenied {
+		t.Fatalf("expected permission denied for another client ip, got %v", err)
+	}
+	if err := guard.Check(ctx, "10.0.0.1", solution); err != nil {
+		t.Fatalf("expected valid solution, got %v", err)
+	}
+	if err := guard.Check(ctx, "10.0.0.1", solution); models.Code(err) != models.PermissionDenied {
+		t.Fatalf("expected permission denied for the replayed solution, got %v", err)
+	}
+}
+
+func TestAdaptiveModeRequiresChallengeAfterThreshold(t *testing.T) {
+	guard, err := challenge.NewGuard(challenge.Config{
+		Mode:        challenge.AdaptiveMode,
+		Type:        challenge.ProofOfWorkType,
+		Adaptive:    challenge.AdaptiveConfig{Threshold: 2, Window: time.Minute},
+		ProofOfWork: challenge.ProofOfWorkConfig{Difficulty: 8, TTL: time.Minute, Secret: "secret"},
+	}, newLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	if err := guard.Check(ctx, "10.0.0.1", ""); err != nil {
+		t.Fatalf("expected no challenge below the threshold, got %v", err)
+	}
+	guard.RecordFailure("10.0.0.1")
+
+	c, err := guard.GetChallenge("10.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.Required {
+		t.Fatal("expected the challenge to be required after the threshold")
+	}
+	if err := guard.Check(ctx, "10.0.0.1", ""); models.Code(err) != models.FailedPrecondition {
+		t.Fatalf("expected failed precondition after the threshold, got %v", err)
+	}
+	if err := guard.Check(ctx, "10.0.0.2", ""); err != nil {
+		t.Fatalf("expected no challenge for another client ip, got %v", err)
+	}
+}
+
+func TestCaptcha(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("secret") != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		success := r.PostForm.Get("response") == "valid" && r.PostForm.Get("remoteip") == "10.0.0.1"
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"success":`+strconv.FormatBool(success)+`}`)
+	}))
+	defer server.Close()
+
+	guard, err := challenge.NewGuard(challenge.Config{
+		Mode: challenge.AlwaysMode,
+		Type: challenge.CaptchaType,
+		Captcha: challenge.CaptchaConfig{
+			VerifyURL: server.URL,
+			SiteKey:   "site-key",
+			Secret:    "secret",
+			Timeout:   time.Second,
+		},
+	}, newLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := guard.GetChallenge("10.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Type != challenge.CaptchaType || c.SiteKey != "site-key" {
+		t.Fatalf("unexpected challenge %+v", c)
+	}
+
+	ctx := context.Background()
+	if err := guard.Check(ctx, "10.0.0.1", "valid"); err != nil {
+		t.Fatalf("expected valid captcha, got %v", err)
+	}
+	if err := guard.Check(ctx, "10.0.0.1", "invalid"); models.Code(err) != models.PermissionDenied {
+		t.Fatalf("expected permission denied for invalid captcha, got %v", err)
+	}
+}
+
+func TestAdaptiveModeRequiresChallengeFromUntrackedClientsWhenFull(t *testing.T) {
+	guard, err := challenge.NewGuard(challenge.Config{
+		Mode:        challenge.AdaptiveMode,
+		Type:        challenge.ProofOfWorkType,
+		Adaptive:    challenge.AdaptiveConfig{Threshold: 2, Window: time.Minute, MaxClients: 1},
+		ProofOfWork: challenge.ProofOfWorkConfig{Difficulty: 8, TTL: time.Minute, Secret: "secret"},
+	}, newLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	if err := guard.Check(ctx, "10.0.0.1", ""); err != nil {
+		t.Fatalf("expected no challenge for the tracked client, got %v", err)
+	}
+	if err := guard.Check(ctx, "10.0.0.2", ""); models.Code(err) != models.FailedPrecondition {
+		t.Fatalf("expected failed precondition for the untracked client, got %v", err)
+	}
+
+	c, err := guard.GetChallenge("10.0.0.3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.Required {
+		t.Fatal("expected the challenge to be required for the untracked client")
+	}
+	if err := guard.Check(ctx, "10.0.0.3", solve(c.Value, c.Difficulty)); err != nil {
+		t.Fatalf("expected valid solution, got %v", err)
+	}
+}
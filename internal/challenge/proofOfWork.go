@@ -0,0 +1,167 @@
+package challenge
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+type ProofOfWorkConfig struct {
+	// The number of the leading zero bits of the sha256 hash of the solution
+	Difficulty int `yaml:"difficulty" env-default:"20"`
+	// The time the challenge can be solved
+	TTL time.Duration `yaml:"ttl" env-default:"5m"`
+	// The secret to sign the challenges, must be the same for all replicas,
+	// the random secret is generated if empty
+	Secret string `yaml:"secret" env:"POW_CHALLENGE_SECRET"`
+}
+
+const (
+	proofOfWorkVersion    = "v1"
+	proofOfWorkNonceBytes = 16
+	maxProofOfWorkBits    = 32
+)
+
+// proofOfWorkVerifier implements the hashcash-style challenge. The challenge
+// v1.<expires at unix>.<difficulty>.<nonce>.<signature> is signed with the client ip, so it's stateless,
+// the solution is <challenge>:<counter>, which sha256 hash has the difficulty leading zero bits.
+// The used challenges are remembered until they expire, so the solution can't be replayed on the same replica.
+type proofOfWorkVerifier struct {
+	cfg    ProofOfWorkConfig
+	secret []byte
+	logger *logrus.Logger
+
+	mu   sync.Mutex
+	used map[string]time.Time
+}
+
+func NewProofOfWorkVerifier(cfg ProofOfWorkConfig, logger *logrus.Logger) (*proofOfWorkVerifier, error) {
+	if cfg.Difficulty <= 0 || cfg.Difficulty > maxProofOfWorkBits {
+		return nil, fmt.Errorf("proof of work difficulty must be between 1 and %d", maxProofOfWorkBits)
+	}
+
+	secret := []byte(cfg.Secret)
+	if len(secret) == 0 {
+		logger.Warning("proof of work secret not specified, the challenges are valid only for this replica")
+		secret = make([]byte, sha256.Size)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, err
+		}
+	}
+
+	return &proofOfWorkVerifier{
+		cfg:    cfg,
+		secret: secret,
+		logger: logger,
+		used:   make(map[string]time.Time),
+	}, nil
+}
+
+func (v *proofOfWorkVerifier) NewChallenge(clientIP string) (Challenge, error) {
+	nonce := make([]byte, proofOfWorkNonceBytes)
+	if _, err := rand.Read(nonce); err != nil {
+		return Challenge{}, err
+	}
+
+	expiresAt := time.Now().Add(v.cfg.TTL).Truncate(time.Second)
+	payload := strings.Join([]string{proofOfWorkVersion, strconv.FormatInt(expiresAt.Unix(), 10),
+		strconv.Itoa(v.cfg.Difficulty), hex.EncodeToString(nonce)}, ".")
+	return Challenge{
+		Type:       ProofOfWorkType,
+		Value:      payload + "." + v.sign(payload, clientIP),
+		Difficulty: v.cfg.Difficulty,
+		ExpiresAt:  expiresAt.UTC(),
+	}, nil
+}
+
+func (v *proofOfWorkVerifier) Verify(_ context.Context, clientIP, solution string) error {
+	challenge, counter, found := strings.Cut(solution, ":")
+	if !found || counter == "" {
+		return models.Error(models.PermissionDenied, "invalid challenge solution")
+	}
+
+	difficulty, expiresAt, err := v.parseChallenge(challenge, clientIP)
+	if err != nil {
+		return models.Error(models.PermissionDenied, "invalid challenge solution: "+err.Error())
+	}
+	if leadingZeroBits(sha256.Sum256([]byte(solution))) < difficulty {
+		return models.Error(models.PermissionDenied, "invalid challenge solution")
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if _, ok := v.used[challenge]; ok {
+		return models.Error(models.PermissionDenied, "challenge is already used")
+	}
+	v.used[challenge] = expiresAt
+	return nil
+}
+
+// parseChallenge checks the signature and the expiration time of the challenge and returns its difficulty.
+func (v *proofOfWorkVerifier) parseChallenge(challenge, clientIP string) (int, time.Time, error) {
+	parts := strings.Split(challenge, ".")
+	if len(parts) != 5 || parts[0] != proofOfWorkVersion {
+		return 0, time.Time{}, errors.New("unsupported challenge")
+	}
+
+	payload := strings.Join(parts[:4], ".")
+	if !hmac.Equal([]byte(parts[4]), []byte(v.sign(payload, clientIP))) {
+		return 0, time.Time{}, errors.New("challenge isn't issued for this client")
+	}
+
+	expiresAtUnix, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, time.Time{}, errors.New("unsupported challenge")
+	}
+	expiresAt := time.Unix(expiresAtUnix, 0)
+	if !time.Now().Before(expiresAt) {
+		return 0, time.Time{}, errors.New("challenge expired")
+	}
+
+	difficulty, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return 0, time.Time{}, errors.New("unsupported challenge")
+	}
+	return difficulty, expiresAt, nil
+}
+
+func (v *proofOfWorkVerifier) sign(payload, clientIP string) string {
+	mac := hmac.New(sha256.New, v.secret)
+	mac.Write([]byte(payload + "|" + clientIP))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// cleanup forgets the expired used challenges, they are rejected as expired.
+func (v *proofOfWorkVerifier) cleanup() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	now := time.Now()
+	for challenge, expiresAt := range v.used {
+		if !now.Before(expiresAt) {
+			delete(v.used, challenge)
+		}
+	}
+}
+
+func leadingZeroBits(hash [sha256.Size]byte) int {
+	zeros := 0
+	for _, b := range hash {
+		if b != 0 {
+			return zeros + bits.LeadingZeros8(b)
+		}
+		zeros += 8
+	}
+	return zeros
+}
@@ -4,6 +4,10 @@ import (
 	"sync"
 	"time"
 
+	"github.com/Falokut/accounts_service/internal/challenge"
+	"github.com/Falokut/accounts_service/internal/domainpolicy"
+	"github.com/Falokut/accounts_service/internal/events"
+	"github.com/Falokut/accounts_service/internal/geolocation"
 	"github.com/Falokut/accounts_service/internal/repository"
 	"github.com/Falokut/accounts_service/pkg/jaeger"
 
@@ -21,6 +25,8 @@ type Config struct {
 		Mode                   string            `yaml:"server_mode" env:"SERVER_MODE"` // support GRPC, REST, BOTH
 		AllowedHeaders         []string          `yaml:"allowed_headers"`               // Need for REST API gateway, list of metadata headers
 		AllowedOutgoingHeaders map[string]string `yaml:"allowed_outgoing_header"`       // Key - pretty header name, value - header name
+		// The addresses and CIDR ranges of the proxies, which X-Forwarded-For hops are trusted
+		TrustedProxies []string `yaml:"trusted_proxies"`
 	} `yaml:"listen"`
 
 	PrometheusConfig struct {
@@ -28,10 +34,28 @@ type Config struct {
 		ServerConfig metrics.MetricsServerConfig `yaml:"server_config"`
 	} `yaml:"prometheus"`
 
-	NonActivatedAccountTTL time.Duration       `yaml:"nonactivated_account_ttl"`
-	SessionsTTL            time.Duration       `yaml:"sessions_ttl"` // The lifetime of an inactive session in the cache
-	DBConfig               repository.DBConfig `yaml:"db_config"`
-	JaegerConfig           jaeger.Config       `yaml:"jaeger"`
+	NonActivatedAccountTTL time.Duration `yaml:"nonactivated_account_ttl"`
+	SessionsTTL            time.Duration `yaml:"sessions_ttl"` // The lifetime of an inactive session in the cache
+	// The lifetime of an inactive session created with the "remember me" flag
+	RememberMeSessionsTTL time.Duration `yaml:"remember_me_sessions_ttl"`
+	// The absolute lifetime of a session regardless of activity, unlimited if 0
+	SessionsMaxLifetime time.Duration       `yaml:"sessions_max_lifetime"`
+	DBConfig            repository.DBConfig `yaml:"db_config"`
+	JaegerConfig        jaeger.Config       `yaml:"jaeger"`
+	GeolocationConfig   geolocation.Config  `yaml:"geolocation"`
+	// The email domains policy of the registration
+	DomainPolicy domainpolicy.Config `yaml:"domain_policy"`
+	// The CAPTCHA or proof of work challenge on the sign up, sign in and token requests
+	Challenge challenge.Config `yaml:"challenge"`
+
+	SessionsLimit struct {
+		// The maximum number of simultaneous sessions per account, unlimited if 0
+		MaxPerAccount int `yaml:"max_per_account"`
+		// The maximum number of simultaneous sessions per account for the client type, e.g. web: 5
+		MaxPerClientType map[string]int `yaml:"max_per_client_type"`
+		// The action performed when the limit is reached, reject or evict
+		OnLimitExceeded string `yaml:"on_limit_exceeded" env-default:"reject"`
+	} `yaml:"sessions_limit"`
 
 	RegistrationRepositoryConfig struct {
 		Network  string `yaml:"network" env:"REGISTRATION_REPOSITORY_NETWORK"`
@@ -45,6 +69,12 @@ type Config struct {
 		Password string `yaml:"password" env:"SESSIONS_REPOSITORY_PASSWORD"`
 		DB       int    `yaml:"db" env:"SESSIONS_REPOSITORY_DATABASE"`
 	} `yaml:"sessions_repository"`
+	DataExportsRepositoryConfig struct {
+		Network  string `yaml:"network" env:"DATA_EXPORTS_REPOSITORY_NETWORK"`
+		Addr     string `yaml:"addr" env:"DATA_EXPORTS_REPOSITORY_ADDRESS"`
+		Password string `yaml:"password" env:"DATA_EXPORTS_REPOSITORY_PASSWORD"`
+		DB       int    `yaml:"db" env:"DATA_EXPORTS_REPOSITORY_DATABASE"`
+	} `yaml:"data_exports_repository"`
 
 	NumRetriesForTerminateSessions     uint32        `yaml:"num_retries_for_terminate_sessions"`
 	RetrySleepTimeForTerminateSessions time.Duration `yaml:"retry_sleep_time_for_terminate_sessions"`
@@ -60,14 +90,72 @@ type Config struct {
 		VerifyAccountToken struct {
 			Secret string `yaml:"secret" env:"VERIFY_ACCOUNT_TOKEN_SECRET"`
 		} `yaml:"verify_account_token"`
+
+		ServiceAccountToken struct {
+			TTL    time.Duration `yaml:"ttl"`
+			Secret string        `yaml:"secret" env:"SERVICE_ACCOUNT_TOKEN_SECRET"`
+		} `yaml:"service_account_token"`
+
+		RestoreAccountToken struct {
+			TTL    time.Duration `yaml:"ttl"`
+			Secret string        `yaml:"secret" env:"RESTORE_ACCOUNT_TOKEN_SECRET"`
+		} `yaml:"restore_account_token"`
+
+		DataExportToken struct {
+			// The lifetime of the token and the prepared data export
+			TTL    time.Duration `yaml:"ttl" env-default:"24h"`
+			Secret string        `yaml:"secret" env:"DATA_EXPORT_TOKEN_SECRET"`
+		} `yaml:"data_export_token"`
 	} `yaml:"JWT"`
 
-	AccountEventsConfig struct {
-		Brokers []string `yaml:"brokers"`
-	} `yaml:"account_events"`
-	TokensDeliveryConfig struct {
-		Brokers []string `yaml:"brokers"`
-	} `yaml:"tokens_delivery"`
+	// The time after the password is entered in the session, during which the sensitive operations are allowed,
+	// the recent authentication isn't required if 0
+	RecentAuthenticationWindow time.Duration `yaml:"recent_authentication_window"`
+	// The minimum time between the asynchronous data export requests of the account, no cooldown if negative
+	DataExportCooldown time.Duration `yaml:"data_export_cooldown" env-default:"1h"`
+	// The lockout of the password checks of the signed in accounts, e.g. on the reauthentication
+	PasswordVerification struct {
+		// The number of the failed password checks within the window, after which the checks are rejected,
+		// the lockout is disabled if negative
+		MaxFailedAttempts int           `yaml:"max_failed_attempts" env-default:"5"`
+		LockoutWindow     time.Duration `yaml:"lockout_window" env-default:"15m"`
+	} `yaml:"password_verification"`
+
+	AccessTokens struct {
+		MaxTTL              time.Duration `yaml:"max_ttl"`
+		MaxTokensPerAccount int           `yaml:"max_tokens_per_account"`
+	} `yaml:"access_tokens"`
+
+	SignInHistory struct {
+		// The time that the sign in attempts are stored, forever if 0
+		Retention     time.Duration `yaml:"retention"`
+		PruneInterval time.Duration `yaml:"prune_interval" env-default:"1h"`
+	} `yaml:"sign_in_history"`
+
+	AccountDeletion struct {
+		// The time that the deleted account can be restored, the account is deleted immediately if 0
+		GracePeriod   time.Duration `yaml:"grace_period"`
+		PurgeInterval time.Duration `yaml:"purge_interval" env-default:"1h"`
+	} `yaml:"account_deletion"`
+
+	Registration struct {
+		// Who can register the accounts: open or invite_only
+		Mode string `yaml:"mode" env-default:"open"`
+	} `yaml:"registration"`
+
+	AccountEventsConfig events.BrokerConfig `yaml:"account_events"`
+
+	EventsEncoding events.EncodingConfig `yaml:"events_encoding"`
+
+	OutboxConfig struct {
+		RelayInterval time.Duration `yaml:"relay_interval" env-default:"1s"`
+		BatchSize     int           `yaml:"batch_size" env-default:"100"`
+		SentRetention time.Duration `yaml:"sent_retention" env-default:"24h"`
+		MaxAttempts   int           `yaml:"max_attempts" env-default:"100"`
+	} `yaml:"outbox"`
+	TokensDeliveryConfig events.TokensDeliveryConfig `yaml:"tokens_delivery"`
+
+	InboundEventsConfig events.InboundEventsConfig `yaml:"inbound_events"`
 }
 
 var instance *Config
@@ -0,0 +1,179 @@
+package domainpolicy
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/idna"
+)
+
+// Policy decides whether the accounts can be registered with the email domain.
+type Policy interface {
+	CheckDomain(domain string) error
+}
+
+type Config struct {
+	// The domains allowed for the registration, if not empty the other domains are rejected,
+	// e.g. for the internal deployments
+	AllowedDomains []string `yaml:"allowed_domains"`
+	// The domains rejected for the registration
+	BlockedDomains []string `yaml:"blocked_domains"`
+	// The path to the list of the disposable domains, one domain per line, the lines starting with # are ignored,
+	// the disposable domains aren't rejected if empty
+	DisposableDomainsPath string `yaml:"disposable_domains_path"`
+	// The interval between the checks of the modification time of the disposable domains list,
+	// the changed list is reloaded, the reload is disabled if negative
+	ReloadInterval time.Duration `yaml:"reload_interval" env-default:"1m"`
+}
+
+// domainPolicy matches the domains and their subdomains, so blocking example.com blocks mail.example.com too.
+type domainPolicy struct {
+	cfg     Config
+	allowed map[string]struct{}
+	blocked map[string]struct{}
+	logger  *logrus.Logger
+
+	mu         sync.RWMutex
+	disposable map[string]struct{}
+	modTime    time.Time
+}
+
+func NewPolicy(cfg Config, logger *logrus.Logger) (*domainPolicy, error) {
+	p := &domainPolicy{
+		cfg:     cfg,
+		allowed: toDomainsSet(cfg.AllowedDomains),
+		blocked: toDomainsSet(cfg.BlockedDomains),
+		logger:  logger,
+	}
+	if cfg.DisposableDomainsPath == "" {
+		return p, nil
+	}
+
+	if _, err := p.reload(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *domainPolicy) CheckDomain(domain string) error {
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+	if len(p.allowed) > 0 && !matchDomain(p.allowed, domain) {
+		return models.Error(models.PermissionDenied, "registration with this email domain isn't allowed")
+	}
+	if matchDomain(p.blocked, domain) {
+		return models.Error(models.PermissionDenied, "registration with this email domain isn't allowed")
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if matchDomain(p.disposable, domain) {
+		return models.Error(models.PermissionDenied, "disposable email addresses aren't allowed")
+	}
+	return nil
+}
+
+// Run reloads the disposable domains list, when its modification time changes, until the context is canceled.
+func (p *domainPolicy) Run(ctx context.Context) {
+	if p.cfg.DisposableDomainsPath == "" || p.cfg.ReloadInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(p.cfg.ReloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := p.reload()
+			if err != nil {
+				p.logger.Error("disposable domains not reloaded, the previous list is used, error: ", err.Error())
+				continue
+			}
+			if reloaded {
+				p.logger.Info("Disposable domains reloaded")
+			}
+		}
+	}
+}
+
+// reload loads the disposable domains list, if it was modified since the last load.
+func (p *domainPolicy) reload() (bool, error) {
+	info, err := os.Stat(p.cfg.DisposableDomainsPath)
+	if err != nil {
+		return false, err
+	}
+
+	p.mu.RLock()
+	modified := !info.ModTime().Equal(p.modTime)
+	p.mu.RUnlock()
+	if !modified {
+		return false, nil
+	}
+
+	disposable, err := readDomainsList(p.cfg.DisposableDomainsPath)
+	if err != nil {
+		return false, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.disposable = disposable
+	p.modTime = info.ModTime()
+	return true, nil
+}
+
+func readDomainsList(path string) (map[string]struct{}, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var domains []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		domains = append(domains, line)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	return toDomainsSet(domains), nil
+}
+
+// toDomainsSet converts the internationalized domains to ASCII, as the domains of the normalized emails.
+func toDomainsSet(domains []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(domains))
+	for _, domain := range domains {
+		domain = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+		if ascii, err := idna.Lookup.ToASCII(domain); err == nil {
+			domain = ascii
+		}
+		set[domain] = struct{}{}
+	}
+	return set
+}
+
+// matchDomain checks whether the domain or one of its parent domains is in the set.
+func matchDomain(set map[string]struct{}, domain string) bool {
+	for {
+		if _, ok := set[domain]; ok {
+			return true
+		}
+		_, parent, found := strings.Cut(domain, ".")
+		if !found {
+			return false
+		}
+		domain = parent
+	}
+}
@@ -0,0 +1,97 @@
+package domainpolicy_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/domainpolicy"
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+func newLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return logger
+}
+
+func TestPolicyChecksAllowedAndBlockedDomains(t *testing.T) {
+	policy, err := domainpolicy.NewPolicy(domainpolicy.Config{
+		AllowedDomains: []string{"example.com", "Bücher.example"},
+		BlockedDomains: []string{"contractors.example.com"},
+	}, newLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		domain  string
+		allowed bool
+	}{
+		{domain: "example.com", allowed: true},
+		{domain: "mail.example.com", allowed: true},
+		{domain: "xn--bcher-kva.example", allowed: true},
+		{domain: "contractors.example.com", allowed: false},
+		{domain: "eu.contractors.example.com", allowed: false},
+		{domain: "example.org", allowed: false},
+		{domain: "notexample.com", allowed: false},
+	}
+	for _, c := range cases {
+		err = policy.CheckDomain(c.domain)
+		if c.allowed && err != nil {
+			t.Errorf("%s: unexpected error %v", c.domain, err)
+		}
+		if !c.allowed && models.Code(err) != models.PermissionDenied {
+			t.Errorf("%s: expected permission denied error, got %v", c.domain, err)
+		}
+	}
+}
+
+func TestPolicyReloadsDisposableDomains(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "disposable_domains.txt")
+	if err := os.WriteFile(path, []byte("# comment\nmailinator.com\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	policy, err := domainpolicy.NewPolicy(domainpolicy.Config{
+		DisposableDomainsPath: path,
+		ReloadInterval:        10 * time.Millisecond,
+	}, newLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = policy.CheckDomain("mailinator.com"); models.Code(err) != models.PermissionDenied {
+		t.Fatalf("expected permission denied error, got %v", err)
+	}
+	if err = policy.CheckDomain("yopmail.com"); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go policy.Run(ctx)
+
+	if err = os.WriteFile(path, []byte("yopmail.com\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// The modification time must differ from the time of the first load.
+	modTime := time.Now().Add(time.Second)
+	if err = os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for policy.CheckDomain("yopmail.com") == nil {
+		if time.Now().After(deadline) {
+			t.Fatal("disposable domains not reloaded")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err = policy.CheckDomain("mailinator.com"); err != nil {
+		t.Fatalf("unexpected error after reload %v", err)
+	}
+}
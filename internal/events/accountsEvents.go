@@ -2,91 +2,163 @@ package events
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
 
 	"github.com/Falokut/accounts_service/internal/models"
-	"github.com/segmentio/kafka-go"
+	accounts_events "github.com/Falokut/accounts_service/pkg/accounts_events/v1/protos"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type accountsEvents struct {
-	eventsWriter *kafka.Writer
-	logger       *logrus.Logger
+	broker  Broker
+	encoder *eventsEncoder
+	logger  *logrus.Logger
 }
 
-func NewAccountsEvents(cfg KafkaConfig, logger *logrus.Logger) *accountsEvents {
-	w := &kafka.Writer{
-		Addr:                   kafka.TCP(cfg.Brokers...),
-		Logger:                 logger,
-		AllowAutoTopicCreation: true,
-		BatchSize:              1,
-		BatchTimeout:           10 * time.Millisecond,
-		Balancer:               &kafka.LeastBytes{},
-	}
-	return &accountsEvents{eventsWriter: w, logger: logger}
+func NewAccountsEvents(broker Broker, encoder *eventsEncoder, logger *logrus.Logger) *accountsEvents {
+	return &accountsEvents{broker: broker, encoder: encoder, logger: logger}
 }
 
 const (
-	accountCreatedTopic = "account_created"
-	accountDeletedTopic = "account_deleted"
+	accountCreatedTopic  = "account_created"
+	accountDeletedTopic  = "account_deleted"
+	sessionEvictedTopic  = "session_evicted"
+	newDeviceSignInTopic = "new_device_sign_in"
+
+	accountVerifiedTopic        = "account_verified"
+	signInSucceededTopic        = "sign_in_succeeded"
+	signInFailedTopic           = "sign_in_failed"
+	sessionCreatedTopic         = "session_created"
+	sessionTerminatedTopic      = "session_terminated"
+	passwordChangedTopic        = "password_changed"
+	passwordResetRequestedTopic = "password_reset_requested"
+	dataExportReadyTopic        = "data_export_ready"
 )
 
 func (e *accountsEvents) Shutdown() {
 	e.logger.Info("accounts events shutting down")
 
-	err := e.eventsWriter.Close()
+	err := e.broker.Close()
 	if err != nil {
 		e.logger.Errorf("error while shutting down accounts events %v", err)
 	}
 }
 
-func (e *accountsEvents) AccountCreated(ctx context.Context, account models.AccountCreatedDTO) (err error) {
+func (e *accountsEvents) SessionEvicted(ctx context.Context, accountID, sessionID string) (err error) {
 	defer e.handleError(ctx, &err)
-	defer e.logError(err, "AccountCreated")
+	defer e.logError(err, "SessionEvicted")
 
-	body, err := json.Marshal(account)
-	if err != nil {
-		e.logger.Panic(err)
-		return
-	}
+	err = e.writeEvent(ctx, sessionEvictedTopic, accountID, &accounts_events.SessionEvicted{
+		AccountID: accountID,
+		SessionID: sessionID,
+	})
+	return
+}
 
-	err = e.eventsWriter.WriteMessages(ctx, kafka.Message{
-		Topic: accountCreatedTopic,
-		Key:   []byte(fmt.Sprint("account_", account.ID)),
-		Value: body,
+func (e *accountsEvents) NewDeviceSignIn(ctx context.Context, signIn models.NewDeviceSignInDTO) (err error) {
+	defer e.handleError(ctx, &err)
+	defer e.logError(err, "NewDeviceSignIn")
+
+	err = e.writeEvent(ctx, newDeviceSignInTopic, signIn.AccountID, &accounts_events.NewDeviceSignIn{
+		AccountID:  signIn.AccountID,
+		Email:      signIn.Email,
+		ClientIp:   signIn.ClientIP,
+		MachineID:  signIn.MachineID,
+		UserAgent:  signIn.UserAgent,
+		DeviceName: signIn.DeviceName,
+		Location: &accounts_events.Location{
+			Country: signIn.Location.Country,
+			City:    signIn.Location.City,
+		},
+		SignInTime: timestamppb.New(signIn.SignInTime.UTC()),
 	})
+	return
+}
 
+func (e *accountsEvents) SessionTerminated(ctx context.Context,
+	accountID, sessionID string, reason models.SessionTerminationReason) (err error) {
+	defer e.handleError(ctx, &err)
+	defer e.logError(err, "SessionTerminated")
+
+	err = e.writeEvent(ctx, sessionTerminatedTopic, accountID, &accounts_events.SessionTerminated{
+		AccountID: accountID,
+		SessionID: sessionID,
+		Reason:    string(reason),
+	})
 	return
 }
 
-func (e *accountsEvents) AccountDeleted(ctx context.Context, email, accountID string) (err error) {
+func (e *accountsEvents) PasswordChanged(ctx context.Context, accountID, email string) (err error) {
 	defer e.handleError(ctx, &err)
-	defer e.logError(err, "AccountDeleted")
+	defer e.logError(err, "PasswordChanged")
 
-	body, err := json.Marshal(struct {
-		Email     string `json:"email"`
-		AccountID string `json:"account_id"`
-	}{
+	err = e.writeEvent(ctx, passwordChangedTopic, accountID, &accounts_events.PasswordChanged{
+		AccountID: accountID,
 		Email:     email,
+	})
+	return
+}
+
+func (e *accountsEvents) PasswordResetRequested(ctx context.Context, accountID, email string) (err error) {
+	defer e.handleError(ctx, &err)
+	defer e.logError(err, "PasswordResetRequested")
+
+	err = e.writeEvent(ctx, passwordResetRequestedTopic, accountID, &accounts_events.PasswordResetRequested{
 		AccountID: accountID,
+		Email:     email,
+	})
+	return
+}
+
+func (e *accountsEvents) DataExportReady(ctx context.Context,
+	accountID, email, downloadToken string, expiresAt time.Time) (err error) {
+	defer e.handleError(ctx, &err)
+	defer e.logError(err, "DataExportReady")
+
+	err = e.writeEvent(ctx, dataExportReadyTopic, accountID, &accounts_events.DataExportReady{
+		AccountID:     accountID,
+		Email:         email,
+		DownloadToken: downloadToken,
+		ExpiresAt:     timestamppb.New(expiresAt.UTC()),
+	})
+	return
+}
+
+func (e *accountsEvents) ReplayAccountCreated(ctx context.Context, account models.AccountCreatedDTO) (err error) {
+	defer e.handleError(ctx, &err)
+	defer e.logError(err, "ReplayAccountCreated")
+
+	ev, err := e.encoder.encode(accountCreatedTopic, fmt.Sprint("account_", account.ID), &accounts_events.AccountCreated{
+		ID:               account.ID,
+		Username:         account.Username,
+		Email:            account.Email,
+		RegistrationDate: timestamppb.New(account.RegistrationDate.UTC()),
 	})
 	if err != nil {
-		e.logger.Panic(err)
 		return
 	}
+	ev.Headers[replayHeader] = "true"
 
-	err = e.eventsWriter.WriteMessages(ctx, kafka.Message{
-		Topic: accountDeletedTopic,
-		Key:   []byte(fmt.Sprint("account_", accountID)),
-		Value: body,
-	})
-
+	err = e.broker.Publish(ctx, ev)
 	return
 }
 
+// replayHeader is the CloudEvents extension, which marks the republished events.
+const replayHeader = "ce_replay"
+
+func (e *accountsEvents) writeEvent(ctx context.Context, topic, accountID string, payload proto.Message) error {
+	ev, err := e.encoder.encode(topic, fmt.Sprint("account_", accountID), payload)
+	if err != nil {
+		return err
+	}
+
+	return e.broker.Publish(ctx, ev)
+}
+
 func (e *accountsEvents) handleError(ctx context.Context, err *error) {
 	ctxErr := getContextError(ctx)
 	if ctxErr != nil {
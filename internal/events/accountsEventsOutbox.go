@@ -0,0 +1,108 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/Falokut/accounts_service/internal/repository"
+	accounts_events "github.com/Falokut/accounts_service/pkg/accounts_events/v1/protos"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type accountsEventsOutbox struct {
+	repo    repository.OutboxRepository
+	encoder *eventsEncoder
+	logger  *logrus.Logger
+}
+
+func NewAccountsEventsOutbox(repo repository.OutboxRepository,
+	encoder *eventsEncoder, logger *logrus.Logger) *accountsEventsOutbox {
+	return &accountsEventsOutbox{repo: repo, encoder: encoder, logger: logger}
+}
+
+func (e *accountsEventsOutbox) AccountCreated(ctx context.Context,
+	tx repository.Transaction, account models.AccountCreatedDTO) error {
+	return e.addMessage(ctx, tx, accountCreatedTopic, account.ID, &accounts_events.AccountCreated{
+		ID:               account.ID,
+		Username:         account.Username,
+		Email:            account.Email,
+		RegistrationDate: timestamppb.New(account.RegistrationDate.UTC()),
+	})
+}
+
+func (e *accountsEventsOutbox) AccountDeleted(ctx context.Context,
+	tx repository.Transaction, email, accountID string) error {
+	return e.addMessage(ctx, tx, accountDeletedTopic, accountID, &accounts_events.AccountDeleted{
+		Email:     email,
+		AccountID: accountID,
+	})
+}
+
+func (e *accountsEventsOutbox) AccountVerified(ctx context.Context,
+	tx repository.Transaction, accountID, email string) error {
+	return e.addMessage(ctx, tx, accountVerifiedTopic, accountID, &accounts_events.AccountVerified{
+		AccountID: accountID,
+		Email:     email,
+	})
+}
+
+func (e *accountsEventsOutbox) SignInSucceeded(ctx context.Context,
+	tx repository.Transaction, attempt models.SignInAttempt, sessionID string) error {
+	return e.addMessage(ctx, tx, signInSucceededTopic, attempt.AccountID, &accounts_events.SignInSucceeded{
+		AccountID:  attempt.AccountID,
+		SessionID:  sessionID,
+		ClientIp:   attempt.ClientIP,
+		MachineID:  attempt.MachineID,
+		UserAgent:  attempt.UserAgent,
+		SignInTime: timestamppb.New(attempt.AttemptedAt.UTC()),
+	})
+}
+
+func (e *accountsEventsOutbox) SignInFailed(ctx context.Context,
+	tx repository.Transaction, attempt models.SignInAttempt) error {
+	return e.addMessage(ctx, tx, signInFailedTopic, attempt.AccountID, &accounts_events.SignInFailed{
+		AccountID:     attempt.AccountID,
+		ClientIp:      attempt.ClientIP,
+		MachineID:     attempt.MachineID,
+		UserAgent:     attempt.UserAgent,
+		FailureReason: string(attempt.FailureReason),
+		AttemptTime:   timestamppb.New(attempt.AttemptedAt.UTC()),
+	})
+}
+
+func (e *accountsEventsOutbox) SessionCreated(ctx context.Context,
+	tx repository.Transaction, session models.Session) error {
+	return e.addMessage(ctx, tx, sessionCreatedTopic, session.AccountID, &accounts_events.SessionCreated{
+		AccountID:  session.AccountID,
+		SessionID:  session.SessionID,
+		ClientIp:   session.ClientIP,
+		MachineID:  session.MachineID,
+		UserAgent:  session.UserAgent,
+		DeviceName: session.DeviceName,
+		ClientType: session.ClientType,
+		CreatedAt:  timestamppb.New(session.CreatedAt.UTC()),
+	})
+}
+
+// addMessage encodes the event and writes it to the outbox,
+// the headers are stored with the event, so the event id doesn't change on retries.
+func (e *accountsEventsOutbox) addMessage(ctx context.Context,
+	tx repository.Transaction, topic, accountID string, payload proto.Message) error {
+	ev, err := e.encoder.encode(topic, fmt.Sprint("account_", accountID), payload)
+	if err != nil {
+		return models.Error(models.Internal, "can't encode event")
+	}
+
+	e.logger.Info("Writing event to outbox")
+	return e.repo.AddMessage(ctx, tx, models.OutboxMessage{
+		Topic:     ev.Topic,
+		Key:       ev.Key,
+		Payload:   ev.Value,
+		Headers:   ev.Headers,
+		CreatedAt: time.Now().In(time.UTC),
+	})
+}
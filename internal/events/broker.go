@@ -0,0 +1,59 @@
+package events
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Message is the message published to the broker.
+type Message struct {
+	Topic   string
+	Key     string
+	Value   []byte
+	Headers map[string]string
+}
+
+// Broker publishes the messages to the message broker.
+type Broker interface {
+	// Publish publishes the messages in the order they are passed.
+	Publish(ctx context.Context, messages ...Message) error
+	Close() error
+}
+
+type BrokerType string
+
+const (
+	KafkaBroker        BrokerType = "kafka"
+	NATSBroker         BrokerType = "nats"
+	RedisStreamsBroker BrokerType = "redis"
+	MemoryBroker       BrokerType = "memory"
+)
+
+type BrokerConfig struct {
+	// The broker type: kafka, nats, redis or memory
+	Type BrokerType `yaml:"type" env-default:"kafka"`
+	// The kafka settings are inlined, so the brokers are listed in the section itself
+	Kafka KafkaConfig        `yaml:",inline"`
+	NATS  NATSConfig         `yaml:"nats"`
+	Redis RedisStreamsConfig `yaml:"redis"`
+	// The spool of the messages, which the broker failed to publish
+	Spool SpoolConfig `yaml:"spool"`
+}
+
+// NewBroker creates the broker of the configured type.
+func NewBroker(cfg BrokerConfig, logger *logrus.Logger) (Broker, error) {
+	switch cfg.Type {
+	case KafkaBroker, "":
+		return newKafkaBroker(cfg.Kafka, logger)
+	case NATSBroker:
+		return newNATSBroker(cfg.NATS, logger)
+	case RedisStreamsBroker:
+		return newRedisStreamsBroker(cfg.Redis)
+	case MemoryBroker:
+		return newMemoryBroker(logger), nil
+	default:
+		return nil, fmt.Errorf("unsupported broker type %q, expected kafka, nats, redis or memory", cfg.Type)
+	}
+}
@@ -0,0 +1,95 @@
+package events
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func newTestMessage(topic, key string) Message {
+	return Message{
+		Topic:   topic,
+		Key:     key,
+		Value:   []byte(`{"account_id":"1"}`),
+		Headers: map[string]string{"ce_id": "event-" + key, "ce_type": "accounts.v1.SignInFailed"},
+	}
+}
+
+func TestMemoryBroker(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	broker, err := NewBroker(BrokerConfig{Type: MemoryBroker}, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := broker.(*memoryBroker)
+
+	for i := 0; i < memoryBrokerTopicCapacity+1; i++ {
+		if err := b.Publish(context.Background(), newTestMessage("sign_in_failed", "account_1")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := b.Publish(context.Background(), newTestMessage("session_created", "account_2")); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := len(b.Messages("sign_in_failed")); n != memoryBrokerTopicCapacity {
+		t.Errorf("expected %d messages kept, got %d", memoryBrokerTopicCapacity, n)
+	}
+	messages := b.Messages("session_created")
+	if len(messages) != 1 || messages[0].Key != "account_2" {
+		t.Errorf("unexpected session_created messages %+v", messages)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := b.Publish(ctx, newTestMessage("session_created", "account_3")); err == nil {
+		t.Error("expected error for the canceled context")
+	}
+	if n := len(b.Messages("session_created")); n != 1 {
+		t.Errorf("expected the message not published with the canceled context, got %d messages", n)
+	}
+}
+
+func TestNATSMessageMapping(t *testing.T) {
+	msg, msgID := newNATSMsg("accounts", newTestMessage("sign_in_failed", "account_1"))
+	if msg.Subject != "accounts.sign_in_failed" {
+		t.Errorf("unexpected subject %q", msg.Subject)
+	}
+	if string(msg.Data) != `{"account_id":"1"}` {
+		t.Errorf("unexpected data %q", msg.Data)
+	}
+	if msg.Header.Get(messageKeyHeader) != "account_1" {
+		t.Errorf("unexpected key header %q", msg.Header.Get(messageKeyHeader))
+	}
+	if msg.Header.Get("ce_type") != "accounts.v1.SignInFailed" || msg.Header.Get("ce_id") != "event-account_1" {
+		t.Errorf("unexpected headers %v", msg.Header)
+	}
+	if msgID != "event-account_1" {
+		t.Errorf("expected ce_id as the message id, got %q", msgID)
+	}
+
+	message := newTestMessage("sign_in_failed", "account_1")
+	delete(message.Headers, "ce_id")
+	if _, msgID = newNATSMsg("accounts", message); msgID != "" {
+		t.Errorf("expected empty message id without ce_id, got %q", msgID)
+	}
+}
+
+func TestRedisStreamValues(t *testing.T) {
+	values := streamValues(newTestMessage("sign_in_failed", "account_1"))
+	if len(values) != 4 {
+		t.Errorf("expected 4 fields, got %v", values)
+	}
+	if values[messageKeyHeader] != "account_1" {
+		t.Errorf("unexpected key field %v", values[messageKeyHeader])
+	}
+	if value, ok := values[streamValueField].([]byte); !ok || string(value) != `{"account_id":"1"}` {
+		t.Errorf("unexpected value field %v", values[streamValueField])
+	}
+	if values["ce_id"] != "event-account_1" || values["ce_type"] != "accounts.v1.SignInFailed" {
+		t.Errorf("unexpected header fields %v", values)
+	}
+}
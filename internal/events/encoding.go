@@ -0,0 +1,92 @@
+package events
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type Encoding string
+
+const (
+	JSONEncoding     Encoding = "json"
+	ProtobufEncoding Encoding = "protobuf"
+)
+
+type EncodingConfig struct {
+	// The source of the events, the ce_source header
+	Source string `yaml:"source" env-default:"accounts_service"`
+	// The encoding of the topics, which are not specified in TopicsEncoding
+	DefaultEncoding Encoding `yaml:"default" env-default:"json"`
+	// The encoding of the specific topics, e.g. account_created: protobuf
+	TopicsEncoding map[string]Encoding `yaml:"topics"`
+}
+
+const (
+	cloudEventsSpecVersion = "1.0"
+	// eventsSchemaVersion is the version of the events schemas in proto/accounts_events
+	eventsSchemaVersion = "1"
+)
+
+var contentTypes = map[Encoding]string{
+	JSONEncoding:     "application/json",
+	ProtobufEncoding: "application/protobuf",
+}
+
+type eventsEncoder struct {
+	cfg EncodingConfig
+}
+
+func NewEventsEncoder(cfg EncodingConfig) (*eventsEncoder, error) {
+	if cfg.DefaultEncoding == "" {
+		cfg.DefaultEncoding = JSONEncoding
+	}
+	if _, ok := contentTypes[cfg.DefaultEncoding]; !ok {
+		return nil, fmt.Errorf("unsupported events encoding %q", cfg.DefaultEncoding)
+	}
+	for topic, encoding := range cfg.TopicsEncoding {
+		if _, ok := contentTypes[encoding]; !ok {
+			return nil, fmt.Errorf("unsupported events encoding %q for the topic %s", encoding, topic)
+		}
+	}
+
+	return &eventsEncoder{cfg: cfg}, nil
+}
+
+// encode encodes the payload with the encoding of the topic and sets the CloudEvents headers
+// of the kafka protocol binding in the binary content mode.
+func (e *eventsEncoder) encode(topic, key string, payload proto.Message) (ev Message, err error) {
+	encoding, ok := e.cfg.TopicsEncoding[topic]
+	if !ok {
+		encoding = e.cfg.DefaultEncoding
+	}
+
+	var value []byte
+	switch encoding {
+	case ProtobufEncoding:
+		value, err = proto.Marshal(payload)
+	default:
+		value, err = protojson.Marshal(payload)
+	}
+	if err != nil {
+		return
+	}
+
+	return Message{
+		Topic: topic,
+		Key:   key,
+		Value: value,
+		Headers: map[string]string{
+			"ce_specversion":   cloudEventsSpecVersion,
+			"ce_id":            uuid.NewString(),
+			"ce_type":          string(proto.MessageName(payload)),
+			"ce_source":        e.cfg.Source,
+			"ce_time":          time.Now().UTC().Format(time.RFC3339Nano),
+			"ce_schemaversion": eventsSchemaVersion,
+			"content-type":     contentTypes[encoding],
+		},
+	}, nil
+}
@@ -6,12 +6,9 @@ import (
 	"time"
 
 	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/Falokut/accounts_service/internal/repository"
 )
 
-type KafkaConfig struct {
-	Brokers []string
-}
-
 func getContextError(ctx context.Context) (err error) {
 	if ctx.Err() == nil {
 		return nil
@@ -27,12 +24,36 @@ func getContextError(ctx context.Context) (err error) {
 	return
 }
 
+// AccountsEventsOutbox writes the accounts events within the transaction of the change,
+// the events are published by the OutboxRelay after the transaction is committed.
+// The events, which aren't bound to a change, are written without the transaction (nil tx),
+// so the request doesn't wait for the broker.
+type AccountsEventsOutbox interface {
+	AccountCreated(ctx context.Context, tx repository.Transaction, account models.AccountCreatedDTO) error
+	AccountDeleted(ctx context.Context, tx repository.Transaction, email, accountID string) error
+	AccountVerified(ctx context.Context, tx repository.Transaction, accountID, email string) error
+	SignInSucceeded(ctx context.Context, tx repository.Transaction, attempt models.SignInAttempt, sessionID string) error
+	SignInFailed(ctx context.Context, tx repository.Transaction, attempt models.SignInAttempt) error
+	SessionCreated(ctx context.Context, tx repository.Transaction, session models.Session) error
+}
+
+// AccountsEventsMQ publishes the accounts events, which aren't bound to a database transaction.
+// All events are published with the account_<id> key, so the events of an account are in the same partition.
 type AccountsEventsMQ interface {
-	AccountCreated(ctx context.Context, account models.AccountCreatedDTO) error
-	AccountDeleted(ctx context.Context, email, accountID string) error
+	SessionEvicted(ctx context.Context, accountID, sessionID string) error
+	NewDeviceSignIn(ctx context.Context, signIn models.NewDeviceSignInDTO) error
+	SessionTerminated(ctx context.Context, accountID, sessionID string, reason models.SessionTerminationReason) error
+	PasswordChanged(ctx context.Context, accountID, email string) error
+	PasswordResetRequested(ctx context.Context, accountID, email string) error
+	DataExportReady(ctx context.Context, accountID, email, downloadToken string, expiresAt time.Time) error
+
+	// ReplayAccountCreated republishes the account_created event of the existing account with the ce_replay header,
+	// so the consumers are able to backfill the accounts.
+	ReplayAccountCreated(ctx context.Context, account models.AccountCreatedDTO) error
 }
 
 type TokensDeliveryMQ interface {
 	RequestEmailVerificationTokenDelivery(ctx context.Context, email, token, callbackURL string, callbackURLTTL time.Duration) error
 	RequestChangePasswordTokenDelivery(ctx context.Context, email, token, callbackURL string, callbackURLTTL time.Duration) error
+	RequestAccountRestoreTokenDelivery(ctx context.Context, email, token, callbackURL string, callbackURLTTL time.Duration) error
 }
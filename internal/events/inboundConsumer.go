@@ -0,0 +1,278 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	accounts_events "github.com/Falokut/accounts_service/pkg/accounts_events/v1/protos"
+	"github.com/segmentio/kafka-go"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type InboundEventsConfig struct {
+	// The addresses of the kafka brokers, the consumer is disabled if empty
+	Brokers                  []string `yaml:"brokers"`
+	GroupID                  string   `yaml:"group_id" env-default:"accounts_service"`
+	AccountBanRequestedTopic string   `yaml:"account_ban_requested_topic" env-default:"account_ban_requested"`
+	EmailBouncedTopic        string   `yaml:"email_bounced_topic" env-default:"email_bounced"`
+	// The topic of the messages, which can't be applied, the messages are skipped if empty
+	DeadLetterTopic string `yaml:"dead_letter_topic" env-default:"accounts_service_dead_letter"`
+	// The number of attempts to apply the message before it's sent to the dead-letter topic
+	MaxAttempts  int             `yaml:"max_attempts" env-default:"5"`
+	RetryBackoff time.Duration   `yaml:"retry_backoff" env-default:"1s"`
+	TLS          KafkaTLSConfig  `yaml:"tls"`
+	SASL         KafkaSASLConfig `yaml:"sasl"`
+}
+
+// InboundEventsHandler applies the inbound events. The handlers must be idempotent,
+// the message is redelivered if the service stops before the offset is committed.
+type InboundEventsHandler interface {
+	SuspendAccount(ctx context.Context, accountID, reason string) error
+	MarkEmailUndeliverable(ctx context.Context, email string) error
+}
+
+// The headers of the dead-letter messages, the original headers are kept.
+const (
+	deadLetterTopicHeader     = "dlq_original_topic"
+	deadLetterPartitionHeader = "dlq_original_partition"
+	deadLetterOffsetHeader    = "dlq_original_offset"
+	deadLetterErrorHeader     = "dlq_error"
+)
+
+// errPoisonMessage marks the messages, which can't be applied on retry.
+var errPoisonMessage = errors.New("poison message")
+
+// InboundConsumer reads the inbound events from kafka and commits the offset of the message
+// only after the change is applied. The messages, which can't be decoded or applied, are sent
+// to the dead-letter topic.
+type InboundConsumer struct {
+	cfg        InboundEventsConfig
+	reader     *kafka.Reader
+	deadLetter Broker
+	handler    InboundEventsHandler
+	logger     *logrus.Logger
+}
+
+func NewInboundConsumer(cfg InboundEventsConfig, handler InboundEventsHandler,
+	logger *logrus.Logger) (*InboundConsumer, error) {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 1
+	}
+
+	kafkaCfg := KafkaConfig{
+		Brokers:      cfg.Brokers,
+		RequiredAcks: "all",
+		BatchSize:    1,
+		BatchTimeout: 10 * time.Millisecond,
+		MaxAttempts:  10,
+		WriteTimeout: 10 * time.Second,
+		TLS:          cfg.TLS,
+		SASL:         cfg.SASL,
+	}
+	dialer := &kafka.Dialer{Timeout: 10 * time.Second, DualStack: true}
+	if cfg.TLS.Enabled {
+		tlsConfig, err := getKafkaTLSConfig(cfg.TLS)
+		if err != nil {
+			return nil, err
+		}
+		dialer.TLS = tlsConfig
+	}
+	if cfg.SASL.Mechanism != "" {
+		mechanism, err := getKafkaSASLMechanism(cfg.SASL)
+		if err != nil {
+			return nil, err
+		}
+		dialer.SASLMechanism = mechanism
+	}
+
+	deadLetter, err := newKafkaBroker(kafkaCfg, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     cfg.Brokers,
+		GroupID:     cfg.GroupID,
+		GroupTopics: []string{cfg.AccountBanRequestedTopic, cfg.EmailBouncedTopic},
+		Dialer:      dialer,
+		Logger:      logger,
+		ErrorLogger: logger,
+		// The offsets are committed synchronously after the message is applied.
+		CommitInterval: 0,
+	})
+
+	return &InboundConsumer{
+		cfg:        cfg,
+		reader:     reader,
+		deadLetter: deadLetter,
+		handler:    handler,
+		logger:     logger,
+	}, nil
+}
+
+// Run consumes the messages until the context is canceled.
+func (c *InboundConsumer) Run(ctx context.Context) {
+	for {
+		message, err := c.reader.FetchMessage(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			c.logger.Error("inbound message not fetched, error: ", err.Error())
+			if !c.wait(ctx) {
+				return
+			}
+			continue
+		}
+
+		if err = c.process(ctx, message); err != nil {
+			// The context is canceled, the message is redelivered after restart.
+			return
+		}
+
+		if err = c.reader.CommitMessages(ctx, message); err != nil && ctx.Err() == nil {
+			c.logger.Errorf("offset of the %s message %d not committed, error: %s",
+				message.Topic, message.Offset, err.Error())
+		}
+	}
+}
+
+// process applies the message with retries, the message is sent to the dead-letter topic,
+// if it's poison or the attempts are exhausted. The error is returned only if the context is canceled.
+func (c *InboundConsumer) process(ctx context.Context, message kafka.Message) error {
+	var err error
+	for attempt := 1; attempt <= c.cfg.MaxAttempts; attempt++ {
+		err = c.apply(ctx, message)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if models.Code(err) == models.NotFound {
+			c.logger.Warningf("%s message %d skipped, error: %s", message.Topic, message.Offset, err.Error())
+			return nil
+		}
+		if errors.Is(err, errPoisonMessage) || models.Code(err) == models.InvalidArgument {
+			break
+		}
+
+		c.logger.Warningf("%s message %d not applied, attempt %d, error: %s",
+			message.Topic, message.Offset, attempt, err.Error())
+		if attempt < c.cfg.MaxAttempts && !c.wait(ctx) {
+			return ctx.Err()
+		}
+	}
+
+	return c.sendToDeadLetter(ctx, message, err)
+}
+
+func (c *InboundConsumer) apply(ctx context.Context, message kafka.Message) error {
+	switch message.Topic {
+	case c.cfg.AccountBanRequestedTopic:
+		event := &accounts_events.AccountBanRequested{}
+		if err := decodeInboundMessage(message, event); err != nil {
+			return err
+		}
+		return c.handler.SuspendAccount(ctx, event.AccountID, event.Reason)
+	case c.cfg.EmailBouncedTopic:
+		event := &accounts_events.EmailBounced{}
+		if err := decodeInboundMessage(message, event); err != nil {
+			return err
+		}
+		return c.handler.MarkEmailUndeliverable(ctx, event.Email)
+	default:
+		return fmt.Errorf("%w: unexpected topic %s", errPoisonMessage, message.Topic)
+	}
+}
+
+// decodeInboundMessage decodes the payload with the encoding of the content-type header, JSON by default.
+func decodeInboundMessage(message kafka.Message, payload proto.Message) error {
+	var err error
+	if getKafkaHeader(message.Headers, "content-type") == contentTypes[ProtobufEncoding] {
+		err = proto.Unmarshal(message.Value, payload)
+	} else {
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(message.Value, payload)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %s", errPoisonMessage, err.Error())
+	}
+	return nil
+}
+
+// sendToDeadLetter publishes the message to the dead-letter topic with retries,
+// so the offset isn't committed before the message is stored.
+func (c *InboundConsumer) sendToDeadLetter(ctx context.Context, message kafka.Message, cause error) error {
+	if c.cfg.DeadLetterTopic == "" {
+		c.logger.Errorf("%s message %d dropped, error: %s", message.Topic, message.Offset, cause.Error())
+		return nil
+	}
+
+	headers := make(map[string]string, len(message.Headers)+4)
+	for _, header := range message.Headers {
+		headers[header.Key] = string(header.Value)
+	}
+	headers[deadLetterTopicHeader] = message.Topic
+	headers[deadLetterPartitionHeader] = strconv.Itoa(message.Partition)
+	headers[deadLetterOffsetHeader] = strconv.FormatInt(message.Offset, 10)
+	headers[deadLetterErrorHeader] = cause.Error()
+
+	deadLetterMessage := Message{
+		Topic:   c.cfg.DeadLetterTopic,
+		Key:     string(message.Key),
+		Value:   message.Value,
+		Headers: headers,
+	}
+	for {
+		err := c.deadLetter.Publish(ctx, deadLetterMessage)
+		if err == nil {
+			c.logger.Warningf("%s message %d sent to the dead-letter topic, error: %s",
+				message.Topic, message.Offset, cause.Error())
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		c.logger.Error("message not sent to the dead-letter topic, error: ", err.Error())
+		if !c.wait(ctx) {
+			return ctx.Err()
+		}
+	}
+}
+
+// wait waits for the retry backoff, false is returned if the context is canceled.
+func (c *InboundConsumer) wait(ctx context.Context) bool {
+	timer := time.NewTimer(c.cfg.RetryBackoff)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func getKafkaHeader(headers []kafka.Header, key string) string {
+	for _, header := range headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+func (c *InboundConsumer) Shutdown() {
+	c.logger.Info("inbound consumer shutting down")
+	if err := c.reader.Close(); err != nil {
+		c.logger.Errorf("error while closing inbound consumer reader %v", err)
+	}
+	if err := c.deadLetter.Close(); err != nil {
+		c.logger.Errorf("error while closing dead-letter producer %v", err)
+	}
+}
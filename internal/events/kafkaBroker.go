@@ -0,0 +1,209 @@
+package events
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+	"github.com/sirupsen/logrus"
+)
+
+type KafkaConfig struct {
+	// The addresses of the kafka brokers
+	Brokers []string `yaml:"brokers"`
+	// The acknowledgements required from the brokers: none, one or all
+	RequiredAcks string `yaml:"required_acks" env-default:"all"`
+	// The compression codec: none, gzip, snappy, lz4 or zstd
+	Compression string `yaml:"compression" env-default:"none"`
+	// The maximum number of messages in a batch, the messages are written one by one, so the batch
+	// is filled only by the concurrent writes
+	BatchSize    int           `yaml:"batch_size" env-default:"1"`
+	BatchTimeout time.Duration `yaml:"batch_timeout" env-default:"10ms"`
+	// The maximum size of a batch in bytes, 1MB if 0
+	BatchBytes   int64         `yaml:"batch_bytes"`
+	MaxAttempts  int           `yaml:"max_attempts" env-default:"10"`
+	WriteTimeout time.Duration `yaml:"write_timeout" env-default:"10s"`
+	// KeyPartitioning routes the messages by the key, so the messages of an account keep their order
+	// in a single partition. The producer isn't idempotent, the retried write may duplicate the message.
+	KeyPartitioning bool            `yaml:"key_partitioning"`
+	TLS             KafkaTLSConfig  `yaml:"tls"`
+	SASL            KafkaSASLConfig `yaml:"sasl"`
+}
+
+type KafkaTLSConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// The CA certificates to verify the brokers, the system pool is used if empty
+	CAFile string `yaml:"ca_file"`
+	// The client certificate and key for the mutual TLS
+	CertFile           string `yaml:"cert_file"`
+	KeyFile            string `yaml:"key_file"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify"`
+}
+
+type KafkaSASLConfig struct {
+	// The SASL mechanism: plain, scram-sha-256 or scram-sha-512, SASL is disabled if empty
+	Mechanism string `yaml:"mechanism"`
+	Username  string `yaml:"username"`
+	Password  string `yaml:"password"`
+}
+
+var kafkaCompressionCodecs = map[string]kafka.Compression{
+	"gzip":   kafka.Gzip,
+	"snappy": kafka.Snappy,
+	"lz4":    kafka.Lz4,
+	"zstd":   kafka.Zstd,
+}
+
+type kafkaBroker struct {
+	writer *kafka.Writer
+}
+
+func newKafkaBroker(cfg KafkaConfig, logger *logrus.Logger) (*kafkaBroker, error) {
+	var acks kafka.RequiredAcks
+	if cfg.RequiredAcks == "" {
+		cfg.RequiredAcks = "all"
+	}
+	if err := acks.UnmarshalText([]byte(cfg.RequiredAcks)); err != nil {
+		return nil, err
+	}
+
+	var compression kafka.Compression
+	if cfg.Compression != "" && cfg.Compression != "none" {
+		codec, ok := kafkaCompressionCodecs[cfg.Compression]
+		if !ok {
+			return nil, fmt.Errorf("unsupported compression codec %q, expected none, gzip, snappy, lz4 or zstd",
+				cfg.Compression)
+		}
+		compression = codec
+	}
+
+	transport, err := getKafkaTransport(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var balancer kafka.Balancer = &kafka.LeastBytes{}
+	if cfg.KeyPartitioning {
+		balancer = &kafka.Hash{}
+	}
+
+	w := &kafka.Writer{
+		Addr:                   kafka.TCP(cfg.Brokers...),
+		Logger:                 logger,
+		AllowAutoTopicCreation: true,
+		BatchSize:              cfg.BatchSize,
+		BatchTimeout:           cfg.BatchTimeout,
+		BatchBytes:             cfg.BatchBytes,
+		MaxAttempts:            cfg.MaxAttempts,
+		WriteTimeout:           cfg.WriteTimeout,
+		RequiredAcks:           acks,
+		Compression:            compression,
+		Balancer:               balancer,
+		Transport:              transport,
+	}
+	return &kafkaBroker{writer: w}, nil
+}
+
+// getKafkaTransport returns the transport with the TLS and SASL settings, nil for the default transport.
+func getKafkaTransport(cfg KafkaConfig) (*kafka.Transport, error) {
+	if !cfg.TLS.Enabled && cfg.SASL.Mechanism == "" {
+		return nil, nil
+	}
+
+	transport := &kafka.Transport{}
+	if cfg.TLS.Enabled {
+		tlsConfig, err := getKafkaTLSConfig(cfg.TLS)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLS = tlsConfig
+	}
+	if cfg.SASL.Mechanism != "" {
+		mechanism, err := getKafkaSASLMechanism(cfg.SASL)
+		if err != nil {
+			return nil, err
+		}
+		transport.SASL = mechanism
+	}
+	return transport, nil
+}
+
+func getKafkaTLSConfig(cfg KafkaTLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify, //nolint:gosec
+	}
+
+	if cfg.CAFile != "" {
+		ca, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("can't read kafka CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, errors.New("kafka CA file doesn't contain valid certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("can't load kafka client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+func getKafkaSASLMechanism(cfg KafkaSASLConfig) (sasl.Mechanism, error) {
+	switch cfg.Mechanism {
+	case "plain":
+		return plain.Mechanism{Username: cfg.Username, Password: cfg.Password}, nil
+	case "scram-sha-256":
+		return scram.Mechanism(scram.SHA256, cfg.Username, cfg.Password)
+	case "scram-sha-512":
+		return scram.Mechanism(scram.SHA512, cfg.Username, cfg.Password)
+	default:
+		return nil, fmt.Errorf("unsupported SASL mechanism %q, expected plain, scram-sha-256 or scram-sha-512",
+			cfg.Mechanism)
+	}
+}
+
+func (b *kafkaBroker) Publish(ctx context.Context, messages ...Message) error {
+	kafkaMessages := make([]kafka.Message, len(messages))
+	for i := range messages {
+		kafkaMessages[i] = kafka.Message{
+			Topic:   messages[i].Topic,
+			Key:     []byte(messages[i].Key),
+			Value:   messages[i].Value,
+			Headers: getKafkaHeaders(messages[i].Headers),
+		}
+	}
+	return b.writer.WriteMessages(ctx, kafkaMessages...)
+}
+
+func (b *kafkaBroker) Close() error {
+	return b.writer.Close()
+}
+
+// getKafkaHeaders converts the headers to the kafka headers sorted by the key.
+func getKafkaHeaders(headers map[string]string) []kafka.Header {
+	res := make([]kafka.Header, 0, len(headers))
+	for key, value := range headers {
+		res = append(res, kafka.Header{Key: key, Value: []byte(value)})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Key < res[j].Key
+	})
+	return res
+}
@@ -0,0 +1,57 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// memoryBrokerTopicCapacity is the number of the last messages kept for each topic.
+const memoryBrokerTopicCapacity = 1000
+
+// memoryBroker keeps the last published messages in memory and logs them,
+// it's intended for the local development and the tests.
+type memoryBroker struct {
+	mu       sync.RWMutex
+	messages map[string][]Message
+	logger   *logrus.Logger
+}
+
+func newMemoryBroker(logger *logrus.Logger) *memoryBroker {
+	return &memoryBroker{messages: make(map[string][]Message), logger: logger}
+}
+
+func (b *memoryBroker) Publish(ctx context.Context, messages ...Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for i := range messages {
+		topicMessages := append(b.messages[messages[i].Topic], messages[i])
+		if len(topicMessages) > memoryBrokerTopicCapacity {
+			topicMessages = topicMessages[len(topicMessages)-memoryBrokerTopicCapacity:]
+		}
+		b.messages[messages[i].Topic] = topicMessages
+
+		b.logger.WithFields(logrus.Fields{
+			"topic": messages[i].Topic,
+			"key":   messages[i].Key,
+			"value": string(messages[i].Value),
+		}).Debug("message published")
+	}
+	return nil
+}
+
+// Messages returns the last messages published to the topic.
+func (b *memoryBroker) Messages(topic string) []Message {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return append([]Message(nil), b.messages[topic]...)
+}
+
+func (b *memoryBroker) Close() error {
+	return nil
+}
@@ -0,0 +1,105 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/sirupsen/logrus"
+)
+
+type NATSConfig struct {
+	URL             string `yaml:"url"`
+	CredentialsFile string `yaml:"credentials_file"`
+	// The stream, which is created for the subjects with the prefix, if it doesn't exist.
+	// The stream isn't created if empty, so it must be managed outside the service
+	Stream string `yaml:"stream"`
+	// The messages are published to the <subject_prefix>.<topic> subjects
+	SubjectPrefix string        `yaml:"subject_prefix" env-default:"accounts"`
+	MaxAge        time.Duration `yaml:"max_age"`
+}
+
+// messageKeyHeader is the header with the message key, NATS messages don't have a key.
+const messageKeyHeader = "key"
+
+const natsConnectTimeout = 10 * time.Second
+
+type natsBroker struct {
+	conn          *nats.Conn
+	js            jetstream.JetStream
+	subjectPrefix string
+}
+
+func newNATSBroker(cfg NATSConfig, logger *logrus.Logger) (*natsBroker, error) {
+	opts := []nats.Option{
+		nats.Name("accounts_service"),
+		nats.MaxReconnects(-1),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			if err != nil {
+				logger.Warning("nats disconnected, error: ", err.Error())
+			}
+		}),
+	}
+	if cfg.CredentialsFile != "" {
+		opts = append(opts, nats.UserCredentials(cfg.CredentialsFile))
+	}
+
+	conn, err := nats.Connect(cfg.URL, opts...)
+	if err != nil {
+		return nil, err
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if cfg.Stream != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), natsConnectTimeout)
+		defer cancel()
+		_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+			Name:     cfg.Stream,
+			Subjects: []string{cfg.SubjectPrefix + ".>"},
+			MaxAge:   cfg.MaxAge,
+		})
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	return &natsBroker{conn: conn, js: js, subjectPrefix: cfg.SubjectPrefix}, nil
+}
+
+// Publish publishes the messages to the JetStream, the ce_id header is used as the message id,
+// so the redelivered messages are deduplicated by the stream.
+func (b *natsBroker) Publish(ctx context.Context, messages ...Message) error {
+	for i := range messages {
+		msg, msgID := newNATSMsg(b.subjectPrefix, messages[i])
+
+		var opts []jetstream.PublishOpt
+		if msgID != "" {
+			opts = append(opts, jetstream.WithMsgID(msgID))
+		}
+		if _, err := b.js.PublishMsg(ctx, msg, opts...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newNATSMsg converts the message to the NATS message and returns the JetStream message id, if the message has ce_id.
+func newNATSMsg(subjectPrefix string, message Message) (*nats.Msg, string) {
+	msg := nats.NewMsg(subjectPrefix + "." + message.Topic)
+	msg.Data = message.Value
+	for key, value := range message.Headers {
+		msg.Header.Set(key, value)
+	}
+	msg.Header.Set(messageKeyHeader, message.Key)
+	return msg, message.Headers["ce_id"]
+}
+
+func (b *natsBroker) Close() error {
+	return b.conn.Drain()
+}
@@ -0,0 +1,138 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/Falokut/accounts_service/internal/repository"
+	"github.com/sirupsen/logrus"
+)
+
+type OutboxRelayConfig struct {
+	// The interval between the outbox polls, the failed messages are retried on the next poll
+	Interval time.Duration
+	// The maximum number of messages published in one poll iteration
+	BatchSize int
+	// The time that the sent messages are kept in the outbox, sent messages are deleted immediately if 0
+	SentRetention time.Duration
+	// The number of the failed attempts, after which the message is dead-lettered, unlimited if negative
+	MaxAttempts int
+}
+
+const (
+	outboxCleanupInterval      = time.Hour
+	defaultOutboxRelayInterval = time.Second
+	defaultOutboxBatchSize     = 100
+	maxOutboxRelayBackoff      = time.Minute
+)
+
+// OutboxRelay publishes the messages from the transactional outbox to the broker.
+// The messages are published in the order of their creation, the first failed message stops the batch.
+// Several replicas can run the relay, but only one of them relays the messages at a time,
+// so the events aren't reordered unless the message is dead-lettered. After the failure the polls are backed off.
+type OutboxRelay struct {
+	repo   repository.OutboxRepository
+	broker Broker
+	logger *logrus.Logger
+	cfg    OutboxRelayConfig
+}
+
+func NewOutboxRelay(repo repository.OutboxRepository, broker Broker,
+	cfg OutboxRelayConfig, logger *logrus.Logger) *OutboxRelay {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultOutboxRelayInterval
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultOutboxBatchSize
+	}
+	return &OutboxRelay{repo: repo, broker: broker, logger: logger, cfg: cfg}
+}
+
+func (r *OutboxRelay) Shutdown() {
+	r.logger.Info("outbox relay shutting down")
+
+	err := r.broker.Close()
+	if err != nil {
+		r.logger.Errorf("error while shutting down outbox relay %v", err)
+	}
+}
+
+// Run relays the outbox messages until the context is canceled.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	var lastCleanup time.Time
+	wait := r.cfg.Interval
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		if r.relay(ctx) {
+			wait = r.cfg.Interval
+		} else {
+			wait = min(2*wait, max(maxOutboxRelayBackoff, r.cfg.Interval))
+		}
+		if time.Since(lastCleanup) >= outboxCleanupInterval {
+			r.cleanup(ctx)
+			lastCleanup = time.Now()
+		}
+		timer.Reset(wait)
+	}
+}
+
+// relay publishes the outbox messages until the outbox is empty or the relay fails, it returns false on failure.
+func (r *OutboxRelay) relay(ctx context.Context) bool {
+	for ctx.Err() == nil {
+		var publishErr error
+		sent, err := r.repo.RelayMessages(ctx, r.cfg.BatchSize, r.cfg.MaxAttempts,
+			func(ctx context.Context, messages []models.OutboxMessage) (int, error) {
+				var sent int
+				sent, publishErr = r.publish(ctx, messages)
+				return sent, publishErr
+			})
+		if err != nil {
+			r.logger.Warning("outbox messages not relayed, error: ", err.Error())
+			return false
+		}
+		if sent > 0 {
+			r.logger.Infof("%d outbox messages relayed", sent)
+		}
+		if publishErr != nil {
+			return false
+		}
+		if sent < r.cfg.BatchSize {
+			return true
+		}
+	}
+	return true
+}
+
+func (r *OutboxRelay) publish(ctx context.Context, messages []models.OutboxMessage) (int, error) {
+	for i := range messages {
+		err := r.broker.Publish(ctx, Message{
+			Topic:   messages[i].Topic,
+			Key:     messages[i].Key,
+			Value:   messages[i].Payload,
+			Headers: messages[i].Headers,
+		})
+		if err != nil {
+			r.logger.Warning("outbox message not published, error: ", err.Error())
+			return i, err
+		}
+	}
+	return len(messages), nil
+}
+
+func (r *OutboxRelay) cleanup(ctx context.Context) {
+	deleted, err := r.repo.DeleteSentMessages(ctx, time.Now().In(time.UTC).Add(-r.cfg.SentRetention))
+	if err != nil {
+		r.logger.Warning("sent outbox messages not deleted, error: ", err.Error())
+		return
+	}
+	r.logger.Infof("%d sent outbox messages deleted", deleted)
+}
@@ -0,0 +1,76 @@
+package events
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type RedisStreamsConfig struct {
+	Network  string `yaml:"network"`
+	Addr     string `yaml:"addr"`
+	Password string `yaml:"password"`
+	DB       int    `yaml:"db"`
+	// The messages are added to the <stream_prefix><topic> streams
+	StreamPrefix string `yaml:"stream_prefix"`
+	// The approximate maximum length of the streams, unlimited if 0
+	MaxLen int64 `yaml:"max_len"`
+}
+
+// streamValueField is the field of the stream entry with the message value.
+const streamValueField = "value"
+
+type redisStreamsBroker struct {
+	rdb          *redis.Client
+	streamPrefix string
+	maxLen       int64
+}
+
+func newRedisStreamsBroker(cfg RedisStreamsConfig) (*redisStreamsBroker, error) {
+	rdb := redis.NewClient(&redis.Options{
+		Network:  cfg.Network,
+		Addr:     cfg.Addr,
+		Password: cfg.Password,
+		DB:       cfg.DB,
+	})
+
+	if err := rdb.Ping(context.Background()).Err(); err != nil {
+		rdb.Close()
+		return nil, fmt.Errorf("connection is not established: %s", err.Error())
+	}
+
+	return &redisStreamsBroker{rdb: rdb, streamPrefix: cfg.StreamPrefix, maxLen: cfg.MaxLen}, nil
+}
+
+// Publish adds the messages to the streams, the key and the value of the message
+// are stored in the key and value fields, the headers are stored in the fields with their names.
+func (b *redisStreamsBroker) Publish(ctx context.Context, messages ...Message) error {
+	for i := range messages {
+		err := b.rdb.XAdd(ctx, &redis.XAddArgs{
+			Stream: b.streamPrefix + messages[i].Topic,
+			MaxLen: b.maxLen,
+			Approx: true,
+			Values: streamValues(messages[i]),
+		}).Err()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// streamValues returns the fields of the stream entry of the message.
+func streamValues(message Message) map[string]any {
+	values := make(map[string]any, len(message.Headers)+2)
+	for key, value := range message.Headers {
+		values[key] = value
+	}
+	values[messageKeyHeader] = message.Key
+	values[streamValueField] = message.Value
+	return values
+}
+
+func (b *redisStreamsBroker) Close() error {
+	return b.rdb.Close()
+}
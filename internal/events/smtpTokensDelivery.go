@@ -0,0 +1,533 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+type TokensDeliveryBackend string
+
+const (
+	// BrokerTokensDelivery publishes the delivery requests to the message broker.
+	BrokerTokensDelivery TokensDeliveryBackend = "broker"
+	// SMTPTokensDelivery sends the emails directly over SMTP.
+	SMTPTokensDelivery TokensDeliveryBackend = "smtp"
+)
+
+type TokensDeliveryConfig struct {
+	// The delivery backend: broker or smtp
+	Backend TokensDeliveryBackend `yaml:"backend" env-default:"broker"`
+	// The broker settings are inlined, so the existing configurations are kept
+	Broker BrokerConfig `yaml:",inline"`
+	SMTP   SMTPConfig   `yaml:"smtp"`
+}
+
+type SMTPConfig struct {
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port" env-default:"587"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password" env:"SMTP_PASSWORD"`
+	// The sender, e.g. Accounts <no-reply@example.com>
+	From string `yaml:"from"`
+	// The connection security: starttls, tls or none
+	Security           string        `yaml:"security" env-default:"starttls"`
+	InsecureSkipVerify bool          `yaml:"insecure_skip_verify"`
+	Timeout            time.Duration `yaml:"timeout" env-default:"10s"`
+	// The directory of the templates, the templates of a locale are stored in the <dir>/<locale> subdirectory
+	TemplatesDir string `yaml:"templates_dir"`
+	// The locale used, if there are no templates for the locale of the request
+	DefaultLocale string `yaml:"default_locale" env-default:"en"`
+	// The number of the emails waiting to be sent, the delivery requests are rejected if the queue is full
+	QueueSize int `yaml:"queue_size" env-default:"1000"`
+	// The number of the attempts to send an email
+	MaxAttempts int `yaml:"max_attempts" env-default:"5"`
+	// The delay before the first retry, it's doubled after each failed attempt
+	RetryBackoff time.Duration `yaml:"retry_backoff" env-default:"5s"`
+}
+
+// The names of the templates, <name>.txt is required and must define the subject template,
+// <name>.html is optional.
+const (
+	emailVerificationTemplate = "email_verification"
+	passwordChangeTemplate    = "password_change"
+	accountRestoreTemplate    = "account_restore"
+
+	subjectTemplate = "subject"
+)
+
+var requiredEmailTemplates = []string{emailVerificationTemplate, passwordChangeTemplate, accountRestoreTemplate}
+
+type emailTemplate struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// tokenEmailData is passed to the templates.
+type tokenEmailData struct {
+	Email       string
+	Token       string
+	CallbackURL string
+	// The callback url with the token query parameter, empty if the callback url isn't specified
+	Link      string
+	TTL       time.Duration
+	ExpiresAt time.Time
+}
+
+// queuedEmail is the rendered email waiting to be sent.
+type queuedEmail struct {
+	to      string
+	message []byte
+}
+
+// smtpTokensDelivery renders the localized templates and sends the tokens by email over SMTP.
+// The emails are rendered on the request and sent from the bounded queue by the Run loop,
+// so the requests don't wait for the SMTP server and the temporary failures are retried.
+type smtpTokensDelivery struct {
+	cfg       SMTPConfig
+	from      *mail.Address
+	templates map[string]map[string]emailTemplate
+	logger    *logrus.Logger
+
+	queue    chan queuedEmail
+	stop     chan struct{}
+	stopOnce sync.Once
+	running  atomic.Bool
+	done     chan struct{}
+}
+
+// NewSMTPTokensDelivery loads the templates of all locales, the templates of the default locale are required.
+func NewSMTPTokensDelivery(cfg SMTPConfig, logger *logrus.Logger) (*smtpTokensDelivery, error) {
+	if cfg.Host == "" {
+		return nil, errors.New("smtp host isn't specified")
+	}
+	switch cfg.Security {
+	case "starttls", "tls", "none":
+	default:
+		return nil, fmt.Errorf("unsupported smtp security %q, expected starttls, tls or none", cfg.Security)
+	}
+
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid smtp sender: %w", err)
+	}
+
+	templates, err := loadEmailTemplates(cfg.TemplatesDir)
+	if err != nil {
+		return nil, err
+	}
+	cfg.DefaultLocale = strings.ToLower(cfg.DefaultLocale)
+	for _, name := range requiredEmailTemplates {
+		if _, ok := templates[cfg.DefaultLocale][name]; !ok {
+			return nil, fmt.Errorf("template %s of the default locale %s not found", name, cfg.DefaultLocale)
+		}
+	}
+
+	if cfg.QueueSize <= 0 || cfg.MaxAttempts <= 0 {
+		return nil, errors.New("smtp queue size and max attempts must be positive")
+	}
+
+	return &smtpTokensDelivery{
+		cfg:       cfg,
+		from:      from,
+		templates: templates,
+		logger:    logger,
+		queue:     make(chan queuedEmail, cfg.QueueSize),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}, nil
+}
+
+// loadEmailTemplates loads the templates from the locales subdirectories, the locales are case insensitive.
+func loadEmailTemplates(dir string) (map[string]map[string]emailTemplate, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("can't read templates directory: %w", err)
+	}
+
+	templates := make(map[string]map[string]emailTemplate, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		locale := strings.ToLower(entry.Name())
+		templates[locale] = make(map[string]emailTemplate, len(requiredEmailTemplates))
+		for _, name := range requiredEmailTemplates {
+			textPath := filepath.Join(dir, entry.Name(), name+".txt")
+			if _, err = os.Stat(textPath); errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+
+			var tmpl emailTemplate
+			tmpl.text, err = texttemplate.ParseFiles(textPath)
+			if err != nil {
+				return nil, err
+			}
+			if tmpl.text.Lookup(subjectTemplate) == nil {
+				return nil, fmt.Errorf("template %s doesn't define the %s template", textPath, subjectTemplate)
+			}
+
+			htmlPath := filepath.Join(dir, entry.Name(), name+".html")
+			if _, err = os.Stat(htmlPath); err == nil {
+				tmpl.html, err = htmltemplate.ParseFiles(htmlPath)
+				if err != nil {
+					return nil, err
+				}
+			}
+			templates[locale][name] = tmpl
+		}
+	}
+	return templates, nil
+}
+
+func (d *smtpTokensDelivery) RequestEmailVerificationTokenDelivery(ctx context.Context,
+	email, token, callbackURL string, callbackURLTtl time.Duration) (err error) {
+	defer d.handleError(ctx, &err)
+	defer d.logError(&err, "RequestEmailVerificationTokenDelivery")
+
+	err = d.sendToken(ctx, emailVerificationTemplate, email, token, callbackURL, callbackURLTtl)
+	return
+}
+
+func (d *smtpTokensDelivery) RequestChangePasswordTokenDelivery(ctx context.Context,
+	email, token, callbackURL string, callbackURLTtl time.Duration) (err error) {
+	defer d.handleError(ctx, &err)
+	defer d.logError(&err, "RequestChangePasswordTokenDelivery")
+
+	err = d.sendToken(ctx, passwordChangeTemplate, email, token, callbackURL, callbackURLTtl)
+	return
+}
+
+func (d *smtpTokensDelivery) RequestAccountRestoreTokenDelivery(ctx context.Context,
+	email, token, callbackURL string, callbackURLTtl time.Duration) (err error) {
+	defer d.handleError(ctx, &err)
+	defer d.logError(&err, "RequestAccountRestoreTokenDelivery")
+
+	err = d.sendToken(ctx, accountRestoreTemplate, email, token, callbackURL, callbackURLTtl)
+	return
+}
+
+func (d *smtpTokensDelivery) sendToken(ctx context.Context, templateName, email, token, callbackURL string,
+	ttl time.Duration) error {
+	data := tokenEmailData{
+		Email:       email,
+		Token:       token,
+		CallbackURL: callbackURL,
+		Link:        getTokenLink(callbackURL, token),
+		TTL:         ttl,
+		ExpiresAt:   time.Now().In(time.UTC).Add(ttl),
+	}
+
+	tmpl := d.getTemplate(models.RequestInfoFromContext(ctx).Locale, templateName)
+	message, err := d.buildMessage(email, tmpl, data)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case d.queue <- queuedEmail{to: email, message: message}:
+		return nil
+	default:
+		return models.Error(models.ResourceExhausted, "too many emails are waiting to be sent, try again later")
+	}
+}
+
+// Run sends the queued emails until the context is canceled or the delivery is shut down,
+// the emails left in the queue are dropped.
+func (d *smtpTokensDelivery) Run(ctx context.Context) {
+	d.running.Store(true)
+	defer close(d.done)
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.dropQueued()
+			return
+		case <-d.stop:
+			d.dropQueued()
+			return
+		case email := <-d.queue:
+			d.deliver(ctx, email)
+		}
+	}
+}
+
+// deliver sends the email, the temporary failures are retried with the exponential backoff.
+func (d *smtpTokensDelivery) deliver(ctx context.Context, email queuedEmail) {
+	backoff := d.cfg.RetryBackoff
+	for attempt := 1; ; attempt++ {
+		err := d.send(ctx, email.to, email.message)
+		if err == nil {
+			return
+		}
+		if attempt >= d.cfg.MaxAttempts || isPermanentSMTPError(err) {
+			d.logger.Errorf("email not sent after %d attempts, error: %s", attempt, err.Error())
+			return
+		}
+		d.logger.Warningf("email not sent, attempt %d, error: %s", attempt, err.Error())
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-d.stop:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+// isPermanentSMTPError returns true for the 5xx replies, e.g. the rejected recipient, which aren't retried.
+func isPermanentSMTPError(err error) bool {
+	var smtpErr *textproto.Error
+	return errors.As(err, &smtpErr) && smtpErr.Code >= 500
+}
+
+func (d *smtpTokensDelivery) dropQueued() {
+	if dropped := len(d.queue); dropped > 0 {
+		d.logger.Errorf("%d queued emails not sent on shutdown", dropped)
+	}
+}
+
+// getTemplate returns the template of the locale, e.g. en-us, its language, e.g. en, or the default locale.
+func (d *smtpTokensDelivery) getTemplate(locale, name string) emailTemplate {
+	locale = strings.ToLower(locale)
+	language, _, _ := strings.Cut(locale, "-")
+	for _, candidate := range []string{locale, language} {
+		if tmpl, ok := d.templates[candidate][name]; ok {
+			return tmpl
+		}
+	}
+	return d.templates[d.cfg.DefaultLocale][name]
+}
+
+func getTokenLink(callbackURL, token string) string {
+	if callbackURL == "" {
+		return ""
+	}
+	u, err := url.Parse(callbackURL)
+	if err != nil {
+		return ""
+	}
+	query := u.Query()
+	query.Set("token", token)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// buildMessage renders the message, the message is multipart/alternative if the html template exists.
+func (d *smtpTokensDelivery) buildMessage(to string, tmpl emailTemplate, data tokenEmailData) ([]byte, error) {
+	var subject, text, html bytes.Buffer
+	if err := tmpl.text.ExecuteTemplate(&subject, subjectTemplate, data); err != nil {
+		return nil, err
+	}
+	if err := tmpl.text.Execute(&text, data); err != nil {
+		return nil, err
+	}
+	if tmpl.html != nil {
+		if err := tmpl.html.Execute(&html, data); err != nil {
+			return nil, err
+		}
+	}
+
+	var msg bytes.Buffer
+	writeHeader := func(key, value string) {
+		msg.WriteString(key + ": " + value + "\r\n")
+	}
+	writeHeader("From", d.from.String())
+	writeHeader("To", (&mail.Address{Address: to}).String())
+	writeHeader("Subject", mime.QEncoding.Encode("utf-8", strings.TrimSpace(subject.String())))
+	writeHeader("Date", time.Now().Format(time.RFC1123Z))
+	writeHeader("Message-ID", fmt.Sprintf("<%s@%s>", uuid.NewString(), d.getSenderDomain()))
+	writeHeader("MIME-Version", "1.0")
+
+	if tmpl.html == nil {
+		writeHeader("Content-Type", "text/plain; charset=utf-8")
+		writeHeader("Content-Transfer-Encoding", "quoted-printable")
+		msg.WriteString("\r\n")
+		if err := writeQuotedPrintable(&msg, text.Bytes()); err != nil {
+			return nil, err
+		}
+		return msg.Bytes(), nil
+	}
+
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+	for _, part := range []struct {
+		contentType string
+		content     []byte
+	}{
+		{contentType: "text/plain; charset=utf-8", content: text.Bytes()},
+		{contentType: "text/html; charset=utf-8", content: html.Bytes()},
+	} {
+		w, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err = writeQuotedPrintable(w, part.content); err != nil {
+			return nil, err
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return nil, err
+	}
+
+	writeHeader("Content-Type", "multipart/alternative; boundary="+parts.Boundary())
+	msg.WriteString("\r\n")
+	msg.Write(body.Bytes())
+	return msg.Bytes(), nil
+}
+
+func writeQuotedPrintable(w interface{ Write([]byte) (int, error) }, content []byte) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write(content); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+func (d *smtpTokensDelivery) getSenderDomain() string {
+	if _, domain, ok := strings.Cut(d.from.Address, "@"); ok {
+		return domain
+	}
+	return d.cfg.Host
+}
+
+// send sends the message within the timeout or the deadline of the context, whichever is earlier.
+func (d *smtpTokensDelivery) send(ctx context.Context, to string, message []byte) error {
+	addr := net.JoinHostPort(d.cfg.Host, strconv.Itoa(d.cfg.Port))
+	tlsConfig := &tls.Config{
+		ServerName:         d.cfg.Host,
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: d.cfg.InsecureSkipVerify, //nolint:gosec
+	}
+
+	deadline := time.Now().Add(d.cfg.Timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	dialer := &net.Dialer{Deadline: deadline}
+
+	var conn net.Conn
+	var err error
+	if d.cfg.Security == "tls" {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	if err = conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, d.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if d.cfg.Security == "starttls" {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			return errors.New("smtp server doesn't support STARTTLS")
+		}
+		if err = client.StartTLS(tlsConfig); err != nil {
+			return err
+		}
+	}
+	if d.cfg.Username != "" {
+		if err = client.Auth(smtp.PlainAuth("", d.cfg.Username, d.cfg.Password, d.cfg.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err = client.Mail(d.from.Address); err != nil {
+		return err
+	}
+	if err = client.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(message); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// Shutdown stops the Run loop and waits until the email being sent is sent.
+func (d *smtpTokensDelivery) Shutdown() {
+	d.logger.Info("smtp tokens delivery shutting down")
+	d.stopOnce.Do(func() { close(d.stop) })
+	if d.running.Load() {
+		<-d.done
+	}
+}
+
+func (d *smtpTokensDelivery) logError(errptr *error, functionName string) {
+	if errptr == nil || *errptr == nil {
+		return
+	}
+
+	d.logger.WithFields(
+		logrus.Fields{
+			"error.function.name": functionName,
+			"error.msg":           (*errptr).Error(),
+		},
+	).Error("smtp tokens delivery error occurred")
+}
+
+func (d *smtpTokensDelivery) handleError(ctx context.Context, err *error) {
+	ctxErr := getContextError(ctx)
+	if ctxErr != nil {
+		*err = ctxErr
+		return
+	}
+
+	if err == nil || *err == nil {
+		return
+	}
+
+	var serviceErr = &models.ServiceError{}
+	if !errors.As(*err, &serviceErr) {
+		*err = models.Error(models.Internal, "error while sending the email")
+	}
+}
@@ -0,0 +1,267 @@
+package events_test
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/events"
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+// smtpSink is the minimal SMTP server, which stores the received messages.
+type smtpSink struct {
+	listener net.Listener
+	messages chan string
+	// the number of the next transactions rejected with the temporary failure
+	rejects atomic.Int32
+}
+
+func newSMTPSink(t *testing.T) *smtpSink {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	sink := &smtpSink{listener: listener, messages: make(chan string, 1)}
+	go sink.serve()
+	return sink
+}
+
+func (s *smtpSink) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpSink) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *smtpSink) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 localhost ESMTP sink")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "MAIL") && s.rejects.Add(-1) >= 0:
+			reply("451 4.3.0 try again later")
+		case strings.HasPrefix(command, "DATA"):
+			reply("354 end data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				line, err = r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(line, "."))
+			}
+			s.messages <- data.String()
+			reply("250 OK")
+		case strings.HasPrefix(command, "QUIT"):
+			reply("221 bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func writeTemplate(t *testing.T, dir, locale, name, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Join(dir, locale), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, locale, name), []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSMTPTokensDeliveryRendersLocalizedTemplates(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"email_verification", "password_change", "account_restore"} {
+		writeTemplate(t, dir, "en", name+".txt",
+			`{{define "subject"}}Confirm your email{{end}}Open {{.Link}} within {{.TTL}}`)
+	}
+	writeTemplate(t, dir, "ru", "email_verification.txt",
+		`{{define "subject"}}Подтвердите почту{{end}}Перейдите по ссылке {{.Link}}`)
+	writeTemplate(t, dir, "ru", "email_verification.html", `<a href="{{.Link}}">Подтвердить</a>`)
+
+	sink := newSMTPSink(t)
+	delivery := newSMTPTokensDelivery(t, sink, dir)
+
+	cases := []struct {
+		locale      string
+		subject     string
+		text        string
+		contentType string
+	}{
+		{
+			locale:      "ru-RU",
+			subject:     "Подтвердите почту",
+			text:        "Перейдите по ссылке https://example.com/verify?token=secret",
+			contentType: "multipart/alternative",
+		},
+		{
+			locale:      "de-DE",
+			subject:     "Confirm your email",
+			text:        "Open https://example.com/verify?token=secret within 1h0m0s",
+			contentType: "text/plain",
+		},
+	}
+	for _, c := range cases {
+		ctx := models.ContextWithRequestInfo(context.Background(), models.RequestInfo{Locale: c.locale})
+		err := delivery.RequestEmailVerificationTokenDelivery(ctx, "user@example.com", "secret",
+			"https://example.com/verify", time.Hour)
+		if err != nil {
+			t.Fatalf("%s: %v", c.locale, err)
+		}
+
+		var raw string
+		select {
+		case raw = <-sink.messages:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s: message not received", c.locale)
+		}
+
+		message, err := mail.ReadMessage(strings.NewReader(raw))
+		if err != nil {
+			t.Fatal(err)
+		}
+		subject, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if subject != c.subject {
+			t.Errorf("%s: subject %q, expected %q", c.locale, subject, c.subject)
+		}
+
+		mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mediaType != c.contentType {
+			t.Fatalf("%s: content type %q, expected %q", c.locale, mediaType, c.contentType)
+		}
+
+		body := message.Body
+		if mediaType == "multipart/alternative" {
+			part, err := multipart.NewReader(message.Body, params["boundary"]).NextRawPart()
+			if err != nil {
+				t.Fatal(err)
+			}
+			body = part
+		}
+		text, err := io.ReadAll(quotedprintable.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.TrimSpace(string(text)) != c.text {
+			t.Errorf("%s: text %q, expected %q", c.locale, text, c.text)
+		}
+	}
+}
+
+func TestSMTPTokensDeliveryRetriesTemporaryFailures(t *testing.T) {
+	sink := newSMTPSink(t)
+	sink.rejects.Store(2)
+	delivery := newSMTPTokensDelivery(t, sink, filepath.Join("..", "..", "docker", "containers-configs", "templates"))
+
+	err := delivery.RequestAccountRestoreTokenDelivery(context.Background(), "user@example.com", "secret",
+		"https://example.com/restore", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case raw := <-sink.messages:
+		if !strings.Contains(raw, "https://example.com/restore?token=3Dsecret") {
+			t.Errorf("restore link not found in the message %q", raw)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("message not received after the temporary failures")
+	}
+}
+
+type tokensDelivery interface {
+	RequestEmailVerificationTokenDelivery(ctx context.Context, email, token, callbackURL string, ttl time.Duration) error
+	RequestAccountRestoreTokenDelivery(ctx context.Context, email, token, callbackURL string, ttl time.Duration) error
+}
+
+func newSMTPTokensDelivery(t *testing.T, sink *smtpSink, templatesDir string) tokensDelivery {
+	t.Helper()
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	delivery, err := events.NewSMTPTokensDelivery(events.SMTPConfig{
+		Host:          "127.0.0.1",
+		Port:          sink.port(),
+		From:          "Accounts <no-reply@example.com>",
+		Security:      "none",
+		Timeout:       5 * time.Second,
+		TemplatesDir:  templatesDir,
+		DefaultLocale: "en",
+		QueueSize:     10,
+		MaxAttempts:   3,
+		RetryBackoff:  10 * time.Millisecond,
+	}, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go delivery.Run(ctx)
+	t.Cleanup(func() {
+		delivery.Shutdown()
+		cancel()
+	})
+	return delivery
+}
+
+func TestSMTPTokensDeliveryRequiresDefaultLocaleTemplates(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplate(t, dir, "en", "email_verification.txt", `{{define "subject"}}Confirm{{end}}{{.Token}}`)
+
+	_, err := events.NewSMTPTokensDelivery(events.SMTPConfig{
+		Host:          "127.0.0.1",
+		Port:          25,
+		From:          "no-reply@example.com",
+		Security:      "none",
+		TemplatesDir:  dir,
+		DefaultLocale: "en",
+		QueueSize:     10,
+		MaxAttempts:   3,
+	}, logrus.New())
+	if err == nil {
+		t.Fatal("expected error for the missing templates, got nil")
+	}
+}
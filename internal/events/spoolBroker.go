@@ -0,0 +1,271 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+type SpoolConfig struct {
+	// The directory of the spool, the spool is disabled if empty
+	Dir string `yaml:"dir"`
+	// The maximum number of spooled messages, the messages are rejected when the spool is full, unlimited if 0
+	MaxMessages int `yaml:"max_messages"`
+	// The interval between the attempts to replay the spooled messages
+	ReplayInterval time.Duration `yaml:"replay_interval" env-default:"5s"`
+}
+
+type Metrics interface {
+	SetEventsSpoolDepth(spool string, depth int)
+}
+
+const (
+	spoolFileExt       = ".msg"
+	spoolCorruptedExt  = ".corrupted"
+	spoolTmpExt        = ".tmp"
+	defaultSpoolReplay = 5 * time.Second
+)
+
+var ErrSpoolFull = errors.New("events spool is full")
+
+// SpoolBroker buffers the messages, which the broker failed to publish, in the files of the spool directory
+// and replays them in order when the broker recovers. While the spool isn't empty, the new messages are
+// appended to the spool, so they aren't published before the spooled ones.
+type SpoolBroker struct {
+	broker  Broker
+	name    string
+	dir     string
+	cfg     SpoolConfig
+	metrics Metrics
+	logger  *logrus.Logger
+
+	mu      sync.Mutex
+	nextSeq uint64
+	depth   int
+	replay  chan struct{}
+}
+
+// NewSpoolBroker creates the spool in the <dir>/<name> directory and restores the messages spooled before restart.
+func NewSpoolBroker(broker Broker, name string, cfg SpoolConfig,
+	metrics Metrics, logger *logrus.Logger) (*SpoolBroker, error) {
+	if cfg.ReplayInterval <= 0 {
+		cfg.ReplayInterval = defaultSpoolReplay
+	}
+
+	dir := filepath.Join(cfg.Dir, name)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("can't create events spool directory: %w", err)
+	}
+
+	b := &SpoolBroker{
+		broker:  broker,
+		name:    name,
+		dir:     dir,
+		cfg:     cfg,
+		metrics: metrics,
+		logger:  logger,
+		replay:  make(chan struct{}, 1),
+	}
+
+	seqs, err := b.spooledSeqs()
+	if err != nil {
+		return nil, err
+	}
+	if len(seqs) > 0 {
+		b.nextSeq = seqs[len(seqs)-1] + 1
+		logger.Infof("%d spooled %s messages restored", len(seqs), name)
+	}
+	b.setDepth(len(seqs))
+
+	return b, nil
+}
+
+// Publish publishes the messages to the broker, if the broker fails or the spool isn't empty,
+// the messages are spooled and nil is returned.
+func (b *SpoolBroker) Publish(ctx context.Context, messages ...Message) error {
+	b.mu.Lock()
+	spooling := b.depth > 0
+	b.mu.Unlock()
+
+	if !spooling {
+		err := b.broker.Publish(ctx, messages...)
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+		b.logger.Warningf("%s messages not published, spooling, error: %s", b.name, err.Error())
+		if spoolErr := b.spool(messages); spoolErr != nil {
+			b.logger.Error("messages not spooled, error: ", spoolErr.Error())
+			return err
+		}
+		return nil
+	}
+
+	return b.spool(messages)
+}
+
+func (b *SpoolBroker) spool(messages []Message) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.cfg.MaxMessages > 0 && b.depth+len(messages) > b.cfg.MaxMessages {
+		return ErrSpoolFull
+	}
+
+	for i := range messages {
+		if err := b.writeMessage(b.nextSeq, messages[i]); err != nil {
+			return err
+		}
+		b.nextSeq++
+		b.setDepth(b.depth + 1)
+	}
+
+	select {
+	case b.replay <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// writeMessage writes the message to the temporary file and renames it, so the spool never contains partial messages.
+func (b *SpoolBroker) writeMessage(seq uint64, message Message) error {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	path := b.messagePath(seq)
+	f, err := os.OpenFile(path+spoolTmpExt, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o640)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(body); err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path + spoolTmpExt)
+		return err
+	}
+
+	return os.Rename(path+spoolTmpExt, path)
+}
+
+// Run replays the spooled messages until the context is canceled.
+func (b *SpoolBroker) Run(ctx context.Context) {
+	ticker := time.NewTicker(b.cfg.ReplayInterval)
+	defer ticker.Stop()
+
+	for {
+		b.replayMessages(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-b.replay:
+		}
+	}
+}
+
+// replayMessages publishes the spooled messages in order, the first failed message stops the replay.
+func (b *SpoolBroker) replayMessages(ctx context.Context) {
+	for ctx.Err() == nil {
+		seqs, err := b.spooledSeqs()
+		if err != nil {
+			b.logger.Error("spooled messages not listed, error: ", err.Error())
+			return
+		}
+		if len(seqs) == 0 {
+			return
+		}
+
+		for _, seq := range seqs {
+			message, err := b.readMessage(seq)
+			if err != nil {
+				b.logger.Errorf("spooled %s message %d is corrupted, error: %s", b.name, seq, err.Error())
+				if err = os.Rename(b.messagePath(seq), b.messagePath(seq)+spoolCorruptedExt); err != nil {
+					return
+				}
+				b.removed()
+				continue
+			}
+
+			if err = b.broker.Publish(ctx, message); err != nil {
+				b.logger.Warningf("spooled %s messages not replayed, error: %s", b.name, err.Error())
+				return
+			}
+			if err = os.Remove(b.messagePath(seq)); err != nil {
+				b.logger.Error("replayed message not removed from spool, error: ", err.Error())
+				return
+			}
+			b.removed()
+		}
+		b.logger.Infof("%d spooled %s messages replayed", len(seqs), b.name)
+	}
+}
+
+func (b *SpoolBroker) removed() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.setDepth(b.depth - 1)
+}
+
+func (b *SpoolBroker) readMessage(seq uint64) (message Message, err error) {
+	body, err := os.ReadFile(b.messagePath(seq))
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(body, &message)
+	return
+}
+
+// spooledSeqs returns the sequence numbers of the spooled messages in ascending order.
+func (b *SpoolBroker) spooledSeqs() ([]uint64, error) {
+	entries, err := os.ReadDir(b.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	seqs := make([]uint64, 0, len(entries))
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), spoolFileExt)
+		if !ok || entry.IsDir() {
+			continue
+		}
+		seq, err := strconv.ParseUint(name, 10, 64)
+		if err != nil {
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+	return seqs, nil
+}
+
+func (b *SpoolBroker) messagePath(seq uint64) string {
+	return filepath.Join(b.dir, fmt.Sprintf("%020d%s", seq, spoolFileExt))
+}
+
+// setDepth must be called with the mutex locked.
+func (b *SpoolBroker) setDepth(depth int) {
+	b.depth = depth
+	if b.metrics != nil {
+		b.metrics.SetEventsSpoolDepth(b.name, depth)
+	}
+}
+
+func (b *SpoolBroker) Close() error {
+	return b.broker.Close()
+}
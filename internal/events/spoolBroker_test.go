@@ -0,0 +1,133 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/events"
+	"github.com/sirupsen/logrus"
+)
+
+type unstableBroker struct {
+	mu        sync.Mutex
+	available bool
+	published []events.Message
+}
+
+func (b *unstableBroker) Publish(_ context.Context, messages ...events.Message) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.available {
+		return errors.New("broker unavailable")
+	}
+	b.published = append(b.published, messages...)
+	return nil
+}
+
+func (b *unstableBroker) Close() error {
+	return nil
+}
+
+func (b *unstableBroker) setAvailable(available bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.available = available
+}
+
+func (b *unstableBroker) getPublished() []events.Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]events.Message(nil), b.published...)
+}
+
+type depthMetrics struct {
+	mu    sync.Mutex
+	depth int
+}
+
+func (m *depthMetrics) SetEventsSpoolDepth(_ string, depth int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.depth = depth
+}
+
+func (m *depthMetrics) getDepth() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.depth
+}
+
+func newTestLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return logger
+}
+
+func TestSpoolBroker(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	broker := &unstableBroker{}
+	metrics := &depthMetrics{}
+
+	spool, err := events.NewSpoolBroker(broker, "test", events.SpoolConfig{Dir: dir, MaxMessages: 3},
+		metrics, newTestLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"1", "2", "3"} {
+		if err = spool.Publish(ctx, events.Message{Topic: "topic", Key: key, Value: []byte(key)}); err != nil {
+			t.Fatalf("message %s not spooled: %v", key, err)
+		}
+	}
+	if metrics.getDepth() != 3 {
+		t.Fatalf("expected spool depth 3, got %d", metrics.getDepth())
+	}
+	if err = spool.Publish(ctx, events.Message{Topic: "topic", Key: "4"}); !errors.Is(err, events.ErrSpoolFull) {
+		t.Fatalf("expected ErrSpoolFull, got %v", err)
+	}
+
+	// the spooled messages are restored after restart
+	spool, err = events.NewSpoolBroker(broker, "test", events.SpoolConfig{Dir: dir}, metrics, newTestLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metrics.getDepth() != 3 {
+		t.Fatalf("expected restored spool depth 3, got %d", metrics.getDepth())
+	}
+
+	// the new messages aren't published before the spooled ones
+	broker.setAvailable(true)
+	if err = spool.Publish(ctx, events.Message{Topic: "topic", Key: "4", Value: []byte("4")}); err != nil {
+		t.Fatal(err)
+	}
+	if published := broker.getPublished(); len(published) != 0 {
+		t.Fatalf("expected the message to be spooled, %d messages published", len(published))
+	}
+
+	replayCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go spool.Run(replayCtx)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for metrics.getDepth() != 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if metrics.getDepth() != 0 {
+		t.Fatalf("expected empty spool after replay, got depth %d", metrics.getDepth())
+	}
+
+	published := broker.getPublished()
+	if len(published) != 4 {
+		t.Fatalf("expected 4 replayed messages, got %d", len(published))
+	}
+	for i, message := range published {
+		if expected := string(rune('1' + i)); message.Key != expected || string(message.Value) != expected {
+			t.Fatalf("message %d: expected key %s, got %s", i, expected, message.Key)
+		}
+	}
+}
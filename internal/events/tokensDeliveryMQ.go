@@ -7,30 +7,22 @@ import (
 	"time"
 
 	"github.com/Falokut/accounts_service/internal/models"
-	"github.com/segmentio/kafka-go"
 	"github.com/sirupsen/logrus"
 )
 
 type tokensDeliveryMQ struct {
-	eventsWriter *kafka.Writer
-	logger       *logrus.Logger
+	broker Broker
+	logger *logrus.Logger
 }
 
-func NewTokensDeliveryMQ(cfg KafkaConfig, logger *logrus.Logger) *tokensDeliveryMQ {
-	w := &kafka.Writer{
-		Addr:                   kafka.TCP(cfg.Brokers...),
-		Logger:                 logger,
-		AllowAutoTopicCreation: true,
-		BatchSize:              1,
-		BatchTimeout:           10 * time.Millisecond,
-		Balancer:               &kafka.LeastBytes{},
-	}
-	return &tokensDeliveryMQ{eventsWriter: w, logger: logger}
+func NewTokensDeliveryMQ(broker Broker, logger *logrus.Logger) *tokensDeliveryMQ {
+	return &tokensDeliveryMQ{broker: broker, logger: logger}
 }
 
 const (
 	emailVerificationTopic = "email_verification_delivery_request"
 	passwordChangeTopic    = "password_change_delivery_request"
+	accountRestoreTopic    = "account_restore_delivery_request"
 )
 
 type tokenDeviveryRequest struct {
@@ -55,9 +47,9 @@ func (e *tokensDeliveryMQ) RequestEmailVerificationTokenDelivery(ctx context.Con
 		e.logger.Panic(err)
 	}
 
-	err = e.eventsWriter.WriteMessages(ctx, kafka.Message{
+	err = e.broker.Publish(ctx, Message{
 		Topic: emailVerificationTopic,
-		Key:   []byte(email),
+		Key:   email,
 		Value: body,
 	})
 
@@ -80,9 +72,34 @@ func (e *tokensDeliveryMQ) RequestChangePasswordTokenDelivery(ctx context.Contex
 		return
 	}
 
-	err = e.eventsWriter.WriteMessages(ctx, kafka.Message{
+	err = e.broker.Publish(ctx, Message{
 		Topic: passwordChangeTopic,
-		Key:   []byte(email),
+		Key:   email,
+		Value: body,
+	})
+
+	return
+}
+
+func (e *tokensDeliveryMQ) RequestAccountRestoreTokenDelivery(ctx context.Context,
+	email, token, callbackURL string, callbackURLTtl time.Duration) (err error) {
+	defer e.handleError(ctx, &err)
+	defer e.logError(err, "RequestAccountRestoreTokenDelivery")
+
+	body, err := json.Marshal(tokenDeviveryRequest{
+		Email:          email,
+		Token:          token,
+		CallbackURL:    callbackURL,
+		CallbackURLTTL: callbackURLTtl,
+	})
+	if err != nil {
+		e.logger.Panic(err)
+		return
+	}
+
+	err = e.broker.Publish(ctx, Message{
+		Topic: accountRestoreTopic,
+		Key:   email,
 		Value: body,
 	})
 
@@ -92,7 +109,7 @@ func (e *tokensDeliveryMQ) RequestChangePasswordTokenDelivery(ctx context.Contex
 func (e *tokensDeliveryMQ) Shutdown() {
 	e.logger.Info("tokens delivery mq shutting down")
 
-	err := e.eventsWriter.Close()
+	err := e.broker.Close()
 	if err != nil {
 		e.logger.Errorf("error while shutting down tokens delivery mq %v", err)
 	}
@@ -0,0 +1,102 @@
+package geolocation
+
+import (
+	"errors"
+	"net"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/oschwald/maxminddb-golang"
+	"github.com/sirupsen/logrus"
+)
+
+// Locator resolves the approximate location of the ip address.
+type Locator interface {
+	Locate(ip string) (models.Location, error)
+}
+
+type Config struct {
+	// path to the offline database file in MaxMind format (GeoLite2-City, GeoIP2-City),
+	// if empty the geolocation is disabled
+	DBPath string `yaml:"db_path" env:"GEOIP_DB_PATH"`
+	// language of the location names, english names are used if the names in the language are missing
+	Language string `yaml:"language" env:"GEOIP_LANGUAGE"`
+}
+
+type maxMindLocator struct {
+	reader   *maxminddb.Reader
+	logger   *logrus.Logger
+	language string
+}
+
+const defaultLanguage = "en"
+
+// NewLocator opens the MaxMind database file from the config,
+// if the path is not specified the locator that resolves nothing is returned.
+func NewLocator(cfg Config, logger *logrus.Logger) (*maxMindLocator, error) {
+	if cfg.DBPath == "" {
+		logger.Warning("geolocation database path not specified, sessions locations will be empty")
+		return &maxMindLocator{logger: logger}, nil
+	}
+
+	reader, err := maxminddb.Open(cfg.DBPath)
+	if err != nil {
+		return nil, err
+	}
+
+	language := cfg.Language
+	if language == "" {
+		language = defaultLanguage
+	}
+	return &maxMindLocator{reader: reader, logger: logger, language: language}, nil
+}
+
+type cityRecord struct {
+	City struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"city"`
+	Country struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"country"`
+}
+
+// Locate returns the country and city of the ip address, empty location is returned for unknown addresses.
+func (l *maxMindLocator) Locate(ip string) (location models.Location, err error) {
+	if l.reader == nil {
+		return
+	}
+
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		err = errors.New("invalid ip address")
+		return
+	}
+
+	var record cityRecord
+	if err = l.reader.Lookup(addr, &record); err != nil {
+		return
+	}
+
+	return models.Location{
+		Country: l.localizedName(record.Country.Names),
+		City:    l.localizedName(record.City.Names),
+	}, nil
+}
+
+func (l *maxMindLocator) localizedName(names map[string]string) string {
+	if name, ok := names[l.language]; ok {
+		return name
+	}
+	return names[defaultLanguage]
+}
+
+// Shutdown closes the database file.
+func (l *maxMindLocator) Shutdown() {
+	if l.reader == nil {
+		return
+	}
+
+	l.logger.Info("geolocation shutting down")
+	if err := l.reader.Close(); err != nil {
+		l.logger.Errorf("error while shutting down geolocation %v", err)
+	}
+}
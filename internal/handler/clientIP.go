@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ParseTrustedProxies parses the addresses and the CIDR ranges of the trusted proxies.
+func ParseTrustedProxies(proxies []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(proxies))
+	for _, proxy := range proxies {
+		if addr, err := netip.ParseAddr(proxy); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q, expected ip address or CIDR", proxy)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
+
+// getClientIP returns the address of the client, the X-Forwarded-For header is trusted only as far as it is
+// appended by the trusted proxies. The gRPC peer is the first hop, the REST gateway calls the service in-process
+// and appends the address of the http client to the header, so it has no peer. The hops are checked from
+// the rightmost one and the first untrusted hop is the client, the leftmost hop is used if all hops are trusted.
+func (h *AccountsServiceHandler) getClientIP(ctx context.Context, md metadata.MD) string {
+	var clientIP string
+	if p, ok := peer.FromContext(ctx); ok {
+		clientIP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(clientIP); err == nil {
+			clientIP = host
+		}
+		if !h.isTrustedProxy(clientIP) {
+			return clientIP
+		}
+	}
+
+	var hops []string
+	for _, value := range md.Get(forwardedForContext) {
+		hops = append(hops, strings.Split(value, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if _, err := netip.ParseAddr(hop); err != nil {
+			break
+		}
+		clientIP = hop
+		if !h.isTrustedProxy(hop) {
+			break
+		}
+	}
+	return clientIP
+}
+
+// withSignInClientIP replaces the client ip of the request info with the client ip of the sign in request,
+// if the caller is the trusted proxy, e.g. the backend signing in on behalf of the user.
+// The client ip of the request from the untrusted caller is ignored, so the client can't pose as another address.
+func (h *AccountsServiceHandler) withSignInClientIP(ctx context.Context, clientIP string) context.Context {
+	if clientIP == "" {
+		return ctx
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if !h.isTrustedProxy(getCallerIP(ctx, md)) {
+		return ctx
+	}
+
+	info := models.RequestInfoFromContext(ctx)
+	info.ClientIP = clientIP
+	return models.ContextWithRequestInfo(ctx, info)
+}
+
+// getCallerIP returns the address of the direct caller: the gRPC peer or, for the REST gateway calls,
+// the address of the http client, which the gateway appends to the X-Forwarded-For header.
+func getCallerIP(ctx context.Context, md metadata.MD) string {
+	if p, ok := peer.FromContext(ctx); ok {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+
+	forwardedFor := md.Get(forwardedForContext)
+	if len(forwardedFor) == 0 {
+		return ""
+	}
+	hops := strings.Split(forwardedFor[len(forwardedFor)-1], ",")
+	return strings.TrimSpace(hops[len(hops)-1])
+}
+
+func (h *AccountsServiceHandler) isTrustedProxy(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range h.trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
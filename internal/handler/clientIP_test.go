@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/Falokut/accounts_service/internal/challenge"
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/Falokut/accounts_service/internal/service"
+	accounts_service "github.com/Falokut/accounts_service/pkg/accounts_service/v1/protos"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestGetClientIP(t *testing.T) {
+	trustedProxies, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &AccountsServiceHandler{trustedProxies: trustedProxies}
+
+	cases := []struct {
+		name         string
+		peer         string
+		forwardedFor []string
+		expected     string
+	}{
+		{name: "grpc peer", peer: "203.0.113.1:5000", expected: "203.0.113.1"},
+		{name: "untrusted grpc peer", peer: "203.0.113.1:5000", forwardedFor: []string{"198.51.100.1"},
+			expected: "203.0.113.1"},
+		{name: "trusted grpc peer", peer: "10.0.0.5:5000", forwardedFor: []string{"198.51.100.1"},
+			expected: "198.51.100.1"},
+		{name: "gateway", forwardedFor: []string{"203.0.113.1"}, expected: "203.0.113.1"},
+		{name: "spoofed hop", forwardedFor: []string{"198.51.100.1, 203.0.113.1"}, expected: "203.0.113.1"},
+		{name: "trusted proxies", forwardedFor: []string{"198.51.100.1, 203.0.113.1, 192.168.1.1, 10.1.2.3"},
+			expected: "203.0.113.1"},
+		{name: "all hops trusted", forwardedFor: []string{"10.0.0.1, 10.0.0.2"}, expected: "10.0.0.1"},
+		{name: "invalid hop", forwardedFor: []string{"evil, 10.0.0.2"}, expected: "10.0.0.2"},
+	}
+	for _, c := range cases {
+		ctx := context.Background()
+		if c.peer != "" {
+			addr, err := net.ResolveTCPAddr("tcp", c.peer)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx = peer.NewContext(ctx, &peer.Peer{Addr: addr})
+		}
+		md := metadata.MD{}
+		if c.forwardedFor != nil {
+			md.Set(forwardedForContext, c.forwardedFor...)
+		}
+		if clientIP := h.getClientIP(ctx, md); clientIP != c.expected {
+			t.Errorf("%s: client ip %q, expected %q", c.name, clientIP, c.expected)
+		}
+	}
+
+	if _, err := ParseTrustedProxies([]string{"proxy.local"}); err == nil {
+		t.Error("expected error for the invalid trusted proxy, got nil")
+	}
+}
+
+// signInServiceStub stores the sign in request.
+type signInServiceStub struct {
+	service.AccountsService
+	dto models.SignInDTO
+}
+
+func (s *signInServiceStub) SignIn(_ context.Context, dto models.SignInDTO) (string, error) {
+	s.dto = dto
+	return "session", nil
+}
+
+func TestSignInUsesClientIPOnlyFromTrustedProxy(t *testing.T) {
+	trustedProxies, err := ParseTrustedProxies([]string{"10.0.0.0/8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	guard, err := challenge.NewGuard(challenge.Config{Mode: challenge.OffMode}, logrus.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	cases := []struct {
+		name     string
+		peer     string
+		expected string
+	}{
+		{name: "untrusted caller", peer: "203.0.113.1:5000", expected: "203.0.113.1"},
+		{name: "trusted proxy", peer: "10.0.0.5:5000", expected: "198.51.100.1"},
+	}
+	for _, c := range cases {
+		stub := &signInServiceStub{}
+		h := NewAccountsServiceHandler(logger, stub, guard, trustedProxies)
+
+		addr, err := net.ResolveTCPAddr("tcp", c.peer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(MachineIDContext, "machine"))
+
+		_, err = h.SignIn(ctx, &accounts_service.SignInRequest{
+			Email:    "bob@example.com",
+			Password: "password",
+			ClientIp: "198.51.100.1",
+		})
+		if err != nil {
+			t.Fatalf("%s: unexpected error %v", c.name, err)
+		}
+		if stub.dto.ClientIP != c.expected {
+			t.Errorf("%s: client ip %q, expected %q", c.name, stub.dto.ClientIP, c.expected)
+		}
+	}
+}
@@ -4,11 +4,16 @@ import (
 	"context"
 	"errors"
 	"net"
+	"net/netip"
+	"strings"
 
+	"github.com/Falokut/accounts_service/internal/challenge"
 	"github.com/Falokut/accounts_service/internal/models"
 	"github.com/Falokut/accounts_service/internal/service"
 	accounts_service "github.com/Falokut/accounts_service/pkg/accounts_service/v1/protos"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/api/httpbody"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -21,28 +26,38 @@ type AccountsServiceHandler struct {
 	accounts_service.UnimplementedAccountsServiceV1Server
 	logger          *logrus.Logger
 	accountsService service.AccountsService
+	challengeGuard  *challenge.Guard
+	// the proxies, which X-Forwarded-For hops are trusted, see getClientIP
+	trustedProxies []netip.Prefix
 }
 
-func NewAccountsServiceHandler(logger *logrus.Logger,
-	accountsService service.AccountsService) *AccountsServiceHandler {
+func NewAccountsServiceHandler(logger *logrus.Logger, accountsService service.AccountsService,
+	challengeGuard *challenge.Guard, trustedProxies []netip.Prefix) *AccountsServiceHandler {
 	return &AccountsServiceHandler{
 		logger:          logger,
 		accountsService: accountsService,
+		challengeGuard:  challengeGuard,
+		trustedProxies:  trustedProxies,
 	}
 }
 
 func (h *AccountsServiceHandler) CreateAccount(ctx context.Context,
 	in *accounts_service.CreateAccountRequest) (res *emptypb.Empty, err error) {
 	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
 
 	if err = validateSignupInput(in); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if err = h.checkChallenge(ctx, models.RequestInfoFromContext(ctx).ClientIP); err != nil {
+		return
+	}
 
 	err = h.accountsService.CreateAccount(ctx, models.CreateAccountDTO{
-		Email:    in.Email,
-		Username: in.Username,
-		Password: in.Password,
+		Email:      in.Email,
+		Username:   in.Username,
+		Password:   in.Password,
+		InviteCode: in.InviteCode,
 	})
 	if err != nil {
 		return
@@ -54,10 +69,14 @@ func (h *AccountsServiceHandler) CreateAccount(ctx context.Context,
 func (h *AccountsServiceHandler) RequestAccountVerificationToken(ctx context.Context,
 	in *accounts_service.VerificationTokenRequest) (res *emptypb.Empty, err error) {
 	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
 
 	if err = validateEmail(in.Email); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if err = h.checkChallenge(ctx, models.RequestInfoFromContext(ctx).ClientIP); err != nil {
+		return
+	}
 
 	err = h.accountsService.RequestAccountVerificationToken(ctx, in.Email, in.URL)
 	if err != nil {
@@ -70,6 +89,7 @@ func (h *AccountsServiceHandler) RequestAccountVerificationToken(ctx context.Con
 func (h *AccountsServiceHandler) VerifyAccount(ctx context.Context,
 	in *accounts_service.VerifyAccountRequest) (res *emptypb.Empty, err error) {
 	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
 
 	err = h.accountsService.VerifyAccount(ctx, in.VerificationToken)
 	if err != nil {
@@ -81,10 +101,22 @@ func (h *AccountsServiceHandler) VerifyAccount(ctx context.Context,
 func (h *AccountsServiceHandler) SignIn(ctx context.Context,
 	in *accounts_service.SignInRequest) (res *accounts_service.AccessResponse, err error) {
 	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
 
-	if net.ParseIP(in.ClientIp) == nil {
+	if in.ClientIp != "" && net.ParseIP(in.ClientIp) == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid client ip address")
 	}
+	ctx = h.withSignInClientIP(ctx, in.ClientIp)
+	clientIP := models.RequestInfoFromContext(ctx).ClientIP
+	if err = validateDeviceName(in.DeviceName); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err = validateClientType(in.ClientType); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err = h.checkChallenge(ctx, clientIP); err != nil {
+		return
+	}
 
 	machineID, err := h.getMachineIDFromCtx(ctx)
 	if err != nil {
@@ -92,12 +124,19 @@ func (h *AccountsServiceHandler) SignIn(ctx context.Context,
 	}
 
 	sessionID, err := h.accountsService.SignIn(ctx, models.SignInDTO{
-		Email:     in.Email,
-		Password:  in.Password,
-		ClientIP:  in.ClientIp,
-		MachineID: machineID,
+		Email:      in.Email,
+		Password:   in.Password,
+		ClientIP:   clientIP,
+		MachineID:  machineID,
+		UserAgent:  h.getUserAgentFromCtx(ctx),
+		DeviceName: in.DeviceName,
+		RememberMe: in.RememberMe,
+		ClientType: in.ClientType,
 	})
 
+	if code := models.Code(err); code == models.InvalidArgument || code == models.NotFound {
+		h.challengeGuard.RecordFailure(clientIP)
+	}
 	if err != nil {
 		return
 	}
@@ -109,28 +148,51 @@ func (h *AccountsServiceHandler) GetAccountID(ctx context.Context,
 	_ *emptypb.Empty) (res *emptypb.Empty, err error) {
 	defer h.handleError(&err)
 
-	sessionID, machineID, err := h.getAuthHeaders(ctx)
+	header, err := h.getAccountIDHeader(ctx)
 	if err != nil {
 		return
 	}
 
-	accountID, err := h.accountsService.GetAccountID(ctx, sessionID, machineID)
+	err = grpc.SetHeader(ctx, header)
 	if err != nil {
 		return
 	}
 
-	header := metadata.Pairs(AccountIDContext, accountID)
-	err = grpc.SetHeader(ctx, header)
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AccountsServiceHandler) getAccountIDHeader(ctx context.Context) (metadata.MD, error) {
+	token, err := h.getBearerTokenFromCtx(ctx)
 	if err != nil {
-		return
+		return nil, err
+	}
+	if token != "" {
+		subject, tokenErr := h.accountsService.GetTokenSubject(ctx, token)
+		if tokenErr != nil {
+			return nil, tokenErr
+		}
+		return metadata.Pairs(AccountIDContext, subject.AccountID,
+			AccountTypeContext, string(subject.AccountType),
+			AccessTokenScopesContext, strings.Join(subject.Scopes, " ")), nil
 	}
 
-	return &emptypb.Empty{}, nil
+	sessionID, machineID, err := h.getAuthHeaders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := h.accountsService.GetAccountID(ctx, sessionID, machineID)
+	if err != nil {
+		return nil, err
+	}
+	return metadata.Pairs(AccountIDContext, accountID,
+		AccountTypeContext, string(models.UserAccountType)), nil
 }
 
 func (h *AccountsServiceHandler) Logout(ctx context.Context,
 	_ *emptypb.Empty) (_ *emptypb.Empty, err error) {
 	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
 
 	sessionID, machineID, err := h.getAuthHeaders(ctx)
 	if err != nil {
@@ -148,6 +210,11 @@ func (h *AccountsServiceHandler) Logout(ctx context.Context,
 func (h *AccountsServiceHandler) RequestChangePasswordToken(ctx context.Context,
 	in *accounts_service.ChangePasswordTokenRequest) (res *emptypb.Empty, err error) {
 	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
+
+	if err = h.checkChallenge(ctx, models.RequestInfoFromContext(ctx).ClientIP); err != nil {
+		return
+	}
 
 	err = h.accountsService.RequestChangePasswordToken(ctx, in.Email, in.URL)
 	if err != nil {
@@ -160,6 +227,7 @@ func (h *AccountsServiceHandler) RequestChangePasswordToken(ctx context.Context,
 func (h *AccountsServiceHandler) ChangePassword(ctx context.Context,
 	in *accounts_service.ChangePasswordRequest) (res *emptypb.Empty, err error) {
 	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
 
 	err = validatePassword(in.NewPassword)
 	if err != nil {
@@ -175,6 +243,36 @@ func (h *AccountsServiceHandler) ChangePassword(ctx context.Context,
 	return &emptypb.Empty{}, nil
 }
 
+func (h *AccountsServiceHandler) RequestAccountRestoreToken(ctx context.Context,
+	in *accounts_service.AccountRestoreTokenRequest) (res *emptypb.Empty, err error) {
+	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
+
+	if err = h.checkChallenge(ctx, models.RequestInfoFromContext(ctx).ClientIP); err != nil {
+		return
+	}
+
+	err = h.accountsService.RequestAccountRestoreToken(ctx, in.Email, in.URL)
+	if err != nil {
+		return
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AccountsServiceHandler) RestoreAccount(ctx context.Context,
+	in *accounts_service.RestoreAccountRequest) (res *emptypb.Empty, err error) {
+	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
+
+	err = h.accountsService.RestoreAccount(ctx, in.RestoreToken)
+	if err != nil {
+		return
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (h *AccountsServiceHandler) GetAllSessions(ctx context.Context,
 	_ *emptypb.Empty) (res *accounts_service.AllSessionsResponse, err error) {
 	defer h.handleError(&err)
@@ -194,15 +292,54 @@ func (h *AccountsServiceHandler) GetAllSessions(ctx context.Context,
 			ClientIp:     info.ClientIP,
 			MachineId:    info.MachineID,
 			LastActivity: timestamppb.New(info.LastActivity.UTC()),
+			UserAgent:    info.UserAgent,
+			DeviceName:   info.DeviceName,
+			Country:      info.Location.Country,
+			City:         info.Location.City,
+			ClientType:   info.ClientType,
 		}
 	}
 
 	return &accounts_service.AllSessionsResponse{Sessions: sessionsInfo}, nil
 }
 
+func (h *AccountsServiceHandler) GetSignInHistory(ctx context.Context,
+	in *accounts_service.SignInHistoryRequest) (res *accounts_service.SignInHistoryResponse, err error) {
+	defer h.handleError(&err)
+
+	sessionID, machineID, err := h.getAuthHeaders(ctx)
+	if err != nil {
+		return
+	}
+
+	attempts, nextPageToken, err := h.accountsService.GetSignInHistory(ctx, sessionID, machineID,
+		int(in.PageSize), in.PageToken)
+	if err != nil {
+		return
+	}
+
+	res = &accounts_service.SignInHistoryResponse{
+		Attempts:      make([]*accounts_service.SignInAttempt, len(attempts)),
+		NextPageToken: nextPageToken,
+	}
+	for i := range attempts {
+		res.Attempts[i] = &accounts_service.SignInAttempt{
+			AttemptedAt:   timestamppb.New(attempts[i].AttemptedAt.UTC()),
+			ClientIp:      attempts[i].ClientIP,
+			MachineId:     attempts[i].MachineID,
+			UserAgent:     attempts[i].UserAgent,
+			Succeeded:     attempts[i].Succeeded,
+			FailureReason: string(attempts[i].FailureReason),
+		}
+	}
+
+	return res, nil
+}
+
 func (h *AccountsServiceHandler) TerminateSessions(ctx context.Context,
 	in *accounts_service.TerminateSessionsRequest) (res *emptypb.Empty, err error) {
 	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
 
 	sessionID, machineID, err := h.getAuthHeaders(ctx)
 	if err != nil {
@@ -216,16 +353,349 @@ func (h *AccountsServiceHandler) TerminateSessions(ctx context.Context,
 	return &emptypb.Empty{}, nil
 }
 
+func (h *AccountsServiceHandler) TerminateOtherSessions(ctx context.Context,
+	_ *emptypb.Empty) (res *emptypb.Empty, err error) {
+	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
+
+	sessionID, machineID, err := h.getAuthHeaders(ctx)
+	if err != nil {
+		return
+	}
+
+	err = h.accountsService.TerminateOtherSessions(ctx, sessionID, machineID)
+	if err != nil {
+		return
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (h *AccountsServiceHandler) DeleteAccount(ctx context.Context,
+	in *accounts_service.DeleteAccountRequest) (res *emptypb.Empty, err error) {
+	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
+
+	sessionID, machineID, err := h.getAuthHeaders(ctx)
+	if err != nil {
+		return
+	}
+
+	err = h.accountsService.DeleteAccount(ctx, sessionID, machineID, in.Password)
+	if err != nil {
+		return
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AccountsServiceHandler) Reauthenticate(ctx context.Context,
+	in *accounts_service.ReauthenticateRequest) (res *emptypb.Empty, err error) {
+	defer h.handleError(&err)
+
+	sessionID, machineID, err := h.getAuthHeaders(ctx)
+	if err != nil {
+		return
+	}
+
+	err = h.accountsService.Reauthenticate(ctx, sessionID, machineID, in.Password)
+	if err != nil {
+		return
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AccountsServiceHandler) CreateAccessToken(ctx context.Context,
+	in *accounts_service.CreateAccessTokenRequest) (res *accounts_service.CreateAccessTokenResponse, err error) {
+	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
+
+	if err = validateAccessTokenInput(in); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sessionID, machineID, err := h.getAuthHeaders(ctx)
+	if err != nil {
+		return
+	}
+
+	dto := models.CreateAccessTokenDTO{
+		Name:   in.Name,
+		Scopes: in.Scopes,
+	}
+	if in.ExpiresAt != nil {
+		dto.ExpiresAt = in.ExpiresAt.AsTime()
+	}
+
+	token, info, err := h.accountsService.CreateAccessToken(ctx, sessionID, machineID, dto)
+	if err != nil {
+		return
+	}
+
+	return &accounts_service.CreateAccessTokenResponse{
+		Token: token,
+		Info:  convertAccessTokenInfo(info),
+	}, nil
+}
+
+func (h *AccountsServiceHandler) GetAccessTokens(ctx context.Context,
+	_ *emptypb.Empty) (res *accounts_service.AccessTokensResponse, err error) {
+	defer h.handleError(&err)
+
+	sessionID, machineID, err := h.getAuthHeaders(ctx)
+	if err != nil {
+		return
+	}
+
+	tokens, err := h.accountsService.GetAccessTokens(ctx, sessionID, machineID)
+	if err != nil {
+		return
+	}
+
+	res = &accounts_service.AccessTokensResponse{
+		Tokens: make([]*accounts_service.AccessTokenInfo, len(tokens)),
+	}
+	for i := range tokens {
+		res.Tokens[i] = convertAccessTokenInfo(tokens[i])
+	}
+
+	return res, nil
+}
+
+func (h *AccountsServiceHandler) RevokeAccessToken(ctx context.Context,
+	in *accounts_service.RevokeAccessTokenRequest) (res *emptypb.Empty, err error) {
+	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
+
+	if err = validateUUID(in.TokenID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid token id")
+	}
+
+	sessionID, machineID, err := h.getAuthHeaders(ctx)
+	if err != nil {
+		return
+	}
+
+	err = h.accountsService.RevokeAccessToken(ctx, sessionID, machineID, in.TokenID)
+	if err != nil {
+		return
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func convertAccessTokenInfo(token models.AccessToken) *accounts_service.AccessTokenInfo {
+	info := &accounts_service.AccessTokenInfo{
+		ID:        token.ID,
+		Name:      token.Name,
+		Scopes:    token.Scopes,
+		CreatedAt: timestamppb.New(token.CreatedAt.UTC()),
+	}
+	if !token.ExpiresAt.IsZero() {
+		info.ExpiresAt = timestamppb.New(token.ExpiresAt.UTC())
+	}
+	if !token.LastUsedAt.IsZero() {
+		info.LastUsedAt = timestamppb.New(token.LastUsedAt.UTC())
+	}
+	return info
+}
+
+func (h *AccountsServiceHandler) CreateServiceAccount(ctx context.Context,
+	in *accounts_service.CreateServiceAccountRequest) (res *accounts_service.ServiceAccountInfo, err error) {
+	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
+
+	if err = validateServiceAccountName(in.Name); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sessionID, machineID, err := h.getAuthHeaders(ctx)
+	if err != nil {
+		return
+	}
+
+	account, err := h.accountsService.CreateServiceAccount(ctx, sessionID, machineID, in.Name)
+	if err != nil {
+		return
+	}
+
+	return convertServiceAccountInfo(account), nil
+}
+
+func (h *AccountsServiceHandler) GetServiceAccounts(ctx context.Context,
+	_ *emptypb.Empty) (res *accounts_service.ServiceAccountsResponse, err error) {
+	defer h.handleError(&err)
+
+	sessionID, machineID, err := h.getAuthHeaders(ctx)
+	if err != nil {
+		return
+	}
+
+	accounts, err := h.accountsService.GetServiceAccounts(ctx, sessionID, machineID)
+	if err != nil {
+		return
+	}
+
+	res = &accounts_service.ServiceAccountsResponse{
+		ServiceAccounts: make([]*accounts_service.ServiceAccountInfo, len(accounts)),
+	}
+	for i := range accounts {
+		res.ServiceAccounts[i] = convertServiceAccountInfo(accounts[i])
+	}
+
+	return res, nil
+}
+
+func (h *AccountsServiceHandler) IssueServiceAccountCredentials(ctx context.Context,
+	in *accounts_service.IssueServiceAccountCredentialsRequest) (res *accounts_service.ServiceAccountCredentialsResponse, err error) {
+	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
+
+	if err = validateUUID(in.ServiceAccountID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid service account id")
+	}
+
+	sessionID, machineID, err := h.getAuthHeaders(ctx)
+	if err != nil {
+		return
+	}
+
+	clientID, clientSecret, err := h.accountsService.IssueServiceAccountCredentials(ctx,
+		sessionID, machineID, in.ServiceAccountID)
+	if err != nil {
+		return
+	}
+
+	return &accounts_service.ServiceAccountCredentialsResponse{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+	}, nil
+}
+
+func (h *AccountsServiceHandler) RevokeServiceAccountCredentials(ctx context.Context,
+	in *accounts_service.RevokeServiceAccountCredentialsRequest) (res *emptypb.Empty, err error) {
+	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
+
+	if err = validateUUID(in.ServiceAccountID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid service account id")
+	}
+	if in.ClientID == "" {
+		return nil, status.Error(codes.InvalidArgument, "client id mustn't be empty")
+	}
+
+	sessionID, machineID, err := h.getAuthHeaders(ctx)
+	if err != nil {
+		return
+	}
+
+	err = h.accountsService.RevokeServiceAccountCredentials(ctx, sessionID, machineID, in.ServiceAccountID, in.ClientID)
+	if err != nil {
+		return
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AccountsServiceHandler) GetServiceAccountToken(ctx context.Context,
+	in *accounts_service.ServiceAccountTokenRequest) (res *accounts_service.ServiceAccountTokenResponse, err error) {
+	defer h.handleError(&err)
+
+	if in.ClientID == "" || in.ClientSecret == "" {
+		return nil, status.Error(codes.Unauthenticated, "client id and client secret must be specified")
+	}
+
+	token, ttl, err := h.accountsService.GetServiceAccountToken(ctx, in.ClientID, in.ClientSecret)
+	if err != nil {
+		return
+	}
+
+	return &accounts_service.ServiceAccountTokenResponse{
+		AccessToken: token,
+		TokenType:   strings.TrimSpace(bearerPrefix),
+		ExpiresIn:   int64(ttl.Seconds()),
+	}, nil
+}
+
+func convertServiceAccountInfo(account models.ServiceAccount) *accounts_service.ServiceAccountInfo {
+	return &accounts_service.ServiceAccountInfo{
+		ID:        account.ID,
+		Name:      account.Name,
+		CreatedAt: timestamppb.New(account.CreatedAt.UTC()),
+	}
+}
+
+func (h *AccountsServiceHandler) QueryAuditLog(ctx context.Context,
+	in *accounts_service.AuditLogRequest) (res *accounts_service.AuditLogResponse, err error) {
+	defer h.handleError(&err)
+
+	sessionID, machineID, err := h.getAuthHeaders(ctx)
+	if err != nil {
+		return
+	}
+
+	records, nextPageToken, err := h.accountsService.QueryAuditLog(ctx, sessionID, machineID,
+		models.AuditLogFilter{
+			AccountID: in.AccountID,
+			Action:    models.AuditAction(in.Action),
+		}, int(in.PageSize), in.PageToken)
+	if err != nil {
+		return
+	}
+
+	res = &accounts_service.AuditLogResponse{
+		Records:       make([]*accounts_service.AuditRecord, len(records)),
+		NextPageToken: nextPageToken,
+	}
+	for i := range records {
+		res.Records[i] = &accounts_service.AuditRecord{
+			OccurredAt:       timestamppb.New(records[i].OccurredAt.UTC()),
+			ActorID:          records[i].ActorID,
+			SubjectAccountID: records[i].SubjectAccountID,
+			Action:           string(records[i].Action),
+			Target:           records[i].Target,
+			ClientIp:         records[i].ClientIP,
+			RequestID:        records[i].RequestID,
+			Result:           string(records[i].Result),
+			Error:            records[i].Error,
+		}
+	}
+
+	return res, nil
+}
+
+const dataExportContentType = "application/json"
+
+func (h *AccountsServiceHandler) ExportAccountData(ctx context.Context,
+	_ *emptypb.Empty) (res *httpbody.HttpBody, err error) {
+	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
+
+	sessionID, machineID, err := h.getAuthHeaders(ctx)
+	if err != nil {
+		return
+	}
+
+	data, err := h.accountsService.ExportAccountData(ctx, sessionID, machineID)
+	if err != nil {
+		return
+	}
+
+	return &httpbody.HttpBody{ContentType: dataExportContentType, Data: data}, nil
+}
+
+func (h *AccountsServiceHandler) RequestAccountDataExport(ctx context.Context,
 	_ *emptypb.Empty) (res *emptypb.Empty, err error) {
 	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
 
 	sessionID, machineID, err := h.getAuthHeaders(ctx)
 	if err != nil {
 		return
 	}
 
-	err = h.accountsService.DeleteAccount(ctx, sessionID, machineID)
+	err = h.accountsService.RequestAccountDataExport(ctx, sessionID, machineID)
 	if err != nil {
 		return
 	}
@@ -233,6 +703,141 @@ func (h *AccountsServiceHandler) DeleteAccount(ctx context.Context,
 	return &emptypb.Empty{}, nil
 }
 
+func (h *AccountsServiceHandler) DownloadAccountDataExport(ctx context.Context,
+	in *accounts_service.DownloadAccountDataExportRequest) (res *httpbody.HttpBody, err error) {
+	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
+
+	sessionID, machineID, err := h.getAuthHeaders(ctx)
+	if err != nil {
+		return
+	}
+
+	data, err := h.accountsService.DownloadAccountDataExport(ctx, sessionID, machineID, in.Token)
+	if err != nil {
+		return
+	}
+
+	return &httpbody.HttpBody{ContentType: dataExportContentType, Data: data}, nil
+}
+
+func (h *AccountsServiceHandler) CreateInvitation(ctx context.Context,
+	in *accounts_service.CreateInvitationRequest) (res *accounts_service.CreateInvitationResponse, err error) {
+	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
+
+	if err = validateInvitationInput(in); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sessionID, machineID, err := h.getAuthHeaders(ctx)
+	if err != nil {
+		return
+	}
+
+	dto := models.CreateInvitationDTO{
+		Email:   in.Email,
+		MaxUses: int(in.MaxUses),
+	}
+	if in.ExpiresAt != nil {
+		dto.ExpiresAt = in.ExpiresAt.AsTime()
+	}
+
+	code, invitation, err := h.accountsService.CreateInvitation(ctx, sessionID, machineID, dto)
+	if err != nil {
+		return
+	}
+
+	return &accounts_service.CreateInvitationResponse{
+		InviteCode: code,
+		Info:       convertInvitationInfo(invitation),
+	}, nil
+}
+
+func (h *AccountsServiceHandler) GetInvitations(ctx context.Context,
+	_ *emptypb.Empty) (res *accounts_service.InvitationsResponse, err error) {
+	defer h.handleError(&err)
+
+	sessionID, machineID, err := h.getAuthHeaders(ctx)
+	if err != nil {
+		return
+	}
+
+	invitations, err := h.accountsService.GetInvitations(ctx, sessionID, machineID)
+	if err != nil {
+		return
+	}
+
+	res = &accounts_service.InvitationsResponse{
+		Invitations: make([]*accounts_service.InvitationInfo, len(invitations)),
+	}
+	for i := range invitations {
+		res.Invitations[i] = convertInvitationInfo(invitations[i])
+	}
+
+	return res, nil
+}
+
+func (h *AccountsServiceHandler) RevokeInvitation(ctx context.Context,
+	in *accounts_service.RevokeInvitationRequest) (res *emptypb.Empty, err error) {
+	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
+
+	if err = validateUUID(in.InvitationID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid invitation id")
+	}
+
+	sessionID, machineID, err := h.getAuthHeaders(ctx)
+	if err != nil {
+		return
+	}
+
+	err = h.accountsService.RevokeInvitation(ctx, sessionID, machineID, in.InvitationID)
+	if err != nil {
+		return
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func convertInvitationInfo(invitation models.Invitation) *accounts_service.InvitationInfo {
+	info := &accounts_service.InvitationInfo{
+		ID:        invitation.ID,
+		Email:     invitation.Email,
+		MaxUses:   int32(invitation.MaxUses),
+		Uses:      int32(invitation.Uses),
+		CreatedBy: invitation.CreatedBy,
+		CreatedAt: timestamppb.New(invitation.CreatedAt.UTC()),
+	}
+	if !invitation.ExpiresAt.IsZero() {
+		info.ExpiresAt = timestamppb.New(invitation.ExpiresAt.UTC())
+	}
+	return info
+}
+
+func (h *AccountsServiceHandler) GetChallenge(ctx context.Context,
+	_ *emptypb.Empty) (res *accounts_service.ChallengeResponse, err error) {
+	defer h.handleError(&err)
+	ctx = h.withRequestInfo(ctx)
+
+	c, err := h.challengeGuard.GetChallenge(models.RequestInfoFromContext(ctx).ClientIP)
+	if err != nil {
+		return
+	}
+
+	res = &accounts_service.ChallengeResponse{
+		Required:   c.Required,
+		Type:       c.Type,
+		Challenge:  c.Value,
+		Difficulty: int32(c.Difficulty),
+		SiteKey:    c.SiteKey,
+	}
+	if !c.ExpiresAt.IsZero() {
+		res.ExpiresAt = timestamppb.New(c.ExpiresAt.UTC())
+	}
+	return res, nil
+}
+
 func (h *AccountsServiceHandler) getAuthHeaders(ctx context.Context) (sessionID, machineID string, err error) {
 	sessionID, err = h.getSessionIDFromCtx(ctx)
 	if err != nil {
@@ -250,13 +855,39 @@ func (h *AccountsServiceHandler) getAuthHeaders(ctx context.Context) (sessionID,
 
 // --------------------- CONTEXTS ---------------------
 const (
-	AccountIDContext = "X-Account-Id"
-	SessionIDContext = "X-Session-Id"
-	MachineIDContext = "X-Machine-Id"
+	AccountIDContext         = "X-Account-Id"
+	SessionIDContext         = "X-Session-Id"
+	MachineIDContext         = "X-Machine-Id"
+	AuthorizationContext     = "Authorization"
+	AccessTokenScopesContext = "X-Access-Token-Scopes"
+	AccountTypeContext       = "X-Account-Type"
 )
 
 //-----------------------------------------------------
 
+const bearerPrefix = "Bearer "
+
+// getBearerTokenFromCtx returns the token from the Authorization header,
+// if the header is not specified an empty string is returned.
+func (h *AccountsServiceHandler) getBearerTokenFromCtx(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+
+	authorization := md.Get(AuthorizationContext)
+	if len(authorization) == 0 || authorization[0] == "" {
+		return "", nil
+	}
+
+	if len(authorization[0]) <= len(bearerPrefix) ||
+		!strings.EqualFold(authorization[0][:len(bearerPrefix)], bearerPrefix) {
+		return "", status.Error(codes.Unauthenticated, "invalid authorization header, expected Bearer token")
+	}
+
+	return strings.TrimSpace(authorization[0][len(bearerPrefix):]), nil
+}
+
 func (h *AccountsServiceHandler) getSessionIDFromCtx(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -285,6 +916,85 @@ func (h *AccountsServiceHandler) getMachineIDFromCtx(ctx context.Context) (strin
 	return machineID[0], nil
 }
 
+const (
+	// the grpc-gateway passes the user agent of the REST client with the prefix
+	gatewayUserAgentContext = "grpcgateway-user-agent"
+	userAgentContext        = "user-agent"
+	maxUserAgentLength      = 512
+)
+
+// getUserAgentFromCtx returns the user agent of the client or an empty string if it is not specified,
+// the user agent is truncated to the maxUserAgentLength.
+func (h *AccountsServiceHandler) getUserAgentFromCtx(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	userAgent := md.Get(gatewayUserAgentContext)
+	if len(userAgent) == 0 {
+		userAgent = md.Get(userAgentContext)
+	}
+	if len(userAgent) == 0 {
+		return ""
+	}
+
+	if len(userAgent[0]) > maxUserAgentLength {
+		return strings.ToValidUTF8(userAgent[0][:maxUserAgentLength], "")
+	}
+	return userAgent[0]
+}
+
+// ChallengeResponseContext is the header of the challenge solution, see GetChallenge.
+const ChallengeResponseContext = "X-Challenge-Response"
+
+// checkChallenge verifies the challenge solution of the client, if the challenge is required.
+func (h *AccountsServiceHandler) checkChallenge(ctx context.Context, clientIP string) error {
+	var solution string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(ChallengeResponseContext); len(values) > 0 {
+			solution = values[0]
+		}
+	}
+	return h.challengeGuard.Check(ctx, clientIP, solution)
+}
+
+const (
+	RequestIDContext      = "X-Request-Id"
+	forwardedForContext   = "x-forwarded-for"
+	acceptLanguageContext = "accept-language"
+	maxLocaleLength       = 35
+	maxRequestIDLength    = 128
+)
+
+// withRequestInfo returns a copy of the context with the client ip and the request id, which are used in the audit log.
+// The request id is generated, if the client doesn't specify it.
+func (h *AccountsServiceHandler) withRequestInfo(ctx context.Context) context.Context {
+	var info models.RequestInfo
+	md, _ := metadata.FromIncomingContext(ctx)
+	if requestID := md.Get(RequestIDContext); len(requestID) > 0 && requestID[0] != "" {
+		info.RequestID = requestID[0]
+		if len(info.RequestID) > maxRequestIDLength {
+			info.RequestID = info.RequestID[:maxRequestIDLength]
+		}
+	} else {
+		info.RequestID = uuid.NewString()
+	}
+
+	info.ClientIP = h.getClientIP(ctx, md)
+
+	// Only the most preferred language is used, e.g. en-US from "en-US,en;q=0.9".
+	if acceptLanguage := md.Get(acceptLanguageContext); len(acceptLanguage) > 0 {
+		locale := strings.Split(acceptLanguage[0], ",")[0]
+		locale = strings.TrimSpace(strings.Split(locale, ";")[0])
+		if len(locale) <= maxLocaleLength && locale != "*" {
+			info.Locale = locale
+		}
+	}
+
+	return models.ContextWithRequestInfo(ctx, info)
+}
+
 func (h *AccountsServiceHandler) handleError(err *error) {
 	if err == nil || *err == nil {
 		return
@@ -317,6 +1027,10 @@ func convertServiceErrCodeToGrpc(code models.ErrorCode) codes.Code {
 		return codes.DeadlineExceeded
 	case models.PermissionDenied:
 		return codes.PermissionDenied
+	case models.ResourceExhausted:
+		return codes.ResourceExhausted
+	case models.FailedPrecondition:
+		return codes.FailedPrecondition
 	default:
 		return codes.Unknown
 	}
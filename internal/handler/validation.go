@@ -2,9 +2,13 @@ package handler
 
 import (
 	"errors"
+	"fmt"
 	"net/mail"
+	"regexp"
+	"unicode/utf8"
 
 	accounts_service "github.com/Falokut/accounts_service/pkg/accounts_service/v1/protos"
+	"github.com/google/uuid"
 )
 
 func validateSignupInput(input *accounts_service.CreateAccountRequest) error {
@@ -57,3 +61,86 @@ func validateEmail(email string) error {
 	}
 	return nil
 }
+
+const (
+	maxAccessTokenNameLength    = 64
+	maxAccessTokenScopes        = 32
+	maxServiceAccountNameLength = 64
+	maxDeviceNameLength         = 64
+	maxInvitationUses           = 10000
+)
+
+var (
+	scopeRegexp      = regexp.MustCompile(`^[a-z][a-z0-9_.:-]{0,63}$`)
+	clientTypeRegexp = regexp.MustCompile(`^[a-z0-9_-]{0,32}$`)
+)
+
+func validateAccessTokenInput(input *accounts_service.CreateAccessTokenRequest) error {
+	if input == nil {
+		return errors.New("request body not valid")
+	}
+
+	nameLength := utf8.RuneCountInString(input.Name)
+	if nameLength == 0 || nameLength > maxAccessTokenNameLength {
+		return fmt.Errorf("token name must be not empty and less than %d symbols", maxAccessTokenNameLength)
+	}
+
+	if len(input.Scopes) > maxAccessTokenScopes {
+		return fmt.Errorf("token can't have more than %d scopes", maxAccessTokenScopes)
+	}
+	for _, scope := range input.Scopes {
+		if !scopeRegexp.MatchString(scope) {
+			return fmt.Errorf("invalid scope %q, scope must start with a lowercase letter "+
+				"and contain only lowercase letters, digits and symbols _.:-", scope)
+		}
+	}
+
+	return nil
+}
+
+func validateServiceAccountName(name string) error {
+	nameLength := utf8.RuneCountInString(name)
+	if nameLength == 0 || nameLength > maxServiceAccountNameLength {
+		return fmt.Errorf("service account name must be not empty and less than %d symbols", maxServiceAccountNameLength)
+	}
+
+	return nil
+}
+
+func validateInvitationInput(input *accounts_service.CreateInvitationRequest) error {
+	if input == nil {
+		return errors.New("request body not valid")
+	}
+
+	if input.Email != "" {
+		if err := validateEmail(input.Email); err != nil {
+			return err
+		}
+	}
+	if input.MaxUses < 0 || input.MaxUses > maxInvitationUses {
+		return fmt.Errorf("maximum uses of the invitation mustn't be negative or greater than %d", maxInvitationUses)
+	}
+
+	return nil
+}
+
+func validateDeviceName(name string) error {
+	if utf8.RuneCountInString(name) > maxDeviceNameLength {
+		return fmt.Errorf("device name must be less than %d symbols", maxDeviceNameLength)
+	}
+
+	return nil
+}
+
+func validateClientType(clientType string) error {
+	if !clientTypeRegexp.MatchString(clientType) {
+		return errors.New("client type must be less than 32 symbols and contain only lowercase letters, digits and symbols _-")
+	}
+
+	return nil
+}
+
+func validateUUID(id string) error {
+	_, err := uuid.Parse(id)
+	return err
+}
@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// AccessToken represents the personal access token info, the token value itself is never stored.
+type AccessToken struct {
+	ID        string
+	AccountID string
+	Name      string
+	Scopes    []string
+	CreatedAt time.Time
+	// zero value means that token never expires
+	ExpiresAt time.Time
+	// zero value means that token never used
+	LastUsedAt time.Time
+}
+
+type CreateAccessTokenDTO struct {
+	Name   string
+	Scopes []string
+	// zero value means that maximum allowed token lifetime will be used
+	ExpiresAt time.Time
+}
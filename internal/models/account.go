@@ -9,10 +9,29 @@ type Account struct {
 	Email            string    `db:"email" json:"email"`
 	Password         string    `db:"password_hash" json:"-"`
 	RegistrationDate time.Time `db:"registration_date" json:"registration_date"`
+	IsAdmin          bool      `db:"is_admin" json:"is_admin"`
+	// The time after which the account, scheduled for deletion, is purged, zero if the account is active
+	PurgeAt time.Time `json:"-"`
+	// The time when the account was suspended by the moderation, zero if the account isn't suspended
+	SuspendedAt        time.Time `json:"-"`
+	SuspensionReason   string    `json:"suspension_reason,omitempty"`
+	EmailUndeliverable bool      `json:"email_undeliverable"`
+}
+
+// IsPendingDeletion returns true if the account is scheduled for deletion.
+func (a Account) IsPendingDeletion() bool {
+	return !a.PurgeAt.IsZero()
+}
+
+// IsSuspended returns true if the account is suspended by the moderation.
+func (a Account) IsSuspended() bool {
+	return !a.SuspendedAt.IsZero()
 }
 
 // RegisteredAccount represents the account data in the registration database.
 type RegisteredAccount struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
+	// The invitation, which is used when the account is verified, empty if the account is registered without it
+	InvitationID string `json:"invitation_id,omitempty"`
 }
@@ -0,0 +1,61 @@
+package models
+
+import "time"
+
+type AuditAction string
+
+const (
+	AccountCreatedAuditAction                   AuditAction = "account_created"
+	PasswordChangedAuditAction                  AuditAction = "password_changed"
+	LogoutAuditAction                           AuditAction = "logout"
+	SessionsTerminatedAuditAction               AuditAction = "sessions_terminated"
+	OtherSessionsTerminatedAuditAction          AuditAction = "other_sessions_terminated"
+	AccountDeletedAuditAction                   AuditAction = "account_deleted"
+	AccessTokenCreatedAuditAction               AuditAction = "access_token_created"
+	AccessTokenRevokedAuditAction               AuditAction = "access_token_revoked"
+	ServiceAccountCreatedAuditAction            AuditAction = "service_account_created"
+	ServiceAccountCredentialsIssuedAuditAction  AuditAction = "service_account_credentials_issued"
+	ServiceAccountCredentialsRevokedAuditAction AuditAction = "service_account_credentials_revoked"
+	AccountDeletionScheduledAuditAction         AuditAction = "account_deletion_scheduled"
+	AccountRestoredAuditAction                  AuditAction = "account_restored"
+	AccountDataExportRequestedAuditAction       AuditAction = "account_data_export_requested"
+	AccountDataExportedAuditAction              AuditAction = "account_data_exported"
+	AccountSuspendedAuditAction                 AuditAction = "account_suspended"
+	EmailMarkedUndeliverableAuditAction         AuditAction = "email_marked_undeliverable"
+	InvitationCreatedAuditAction                AuditAction = "invitation_created"
+	InvitationRevokedAuditAction                AuditAction = "invitation_revoked"
+	PasswordVerificationFailedAuditAction       AuditAction = "password_verification_failed"
+)
+
+type AuditResult string
+
+const (
+	SuccessAuditResult AuditResult = "success"
+	FailureAuditResult AuditResult = "failure"
+)
+
+// AuditRecord is the record of the security audit log.
+type AuditRecord struct {
+	ID         int64     `db:"id" json:"id"`
+	OccurredAt time.Time `db:"occurred_at" json:"occurred_at"`
+	// the account, which performed the action
+	ActorID string `db:"actor_id" json:"actor_id"`
+	// the account affected by the action
+	SubjectAccountID string      `db:"subject_account_id" json:"subject_account_id"`
+	Action           AuditAction `db:"action" json:"action"`
+	// the id of the affected object, e.g. the access token id
+	Target    string      `db:"target" json:"target,omitempty"`
+	ClientIP  string      `db:"client_ip" json:"client_ip"`
+	RequestID string      `db:"request_id" json:"request_id"`
+	Result    AuditResult `db:"result" json:"result"`
+	Error     string      `db:"error" json:"error,omitempty"`
+}
+
+// AuditLogFilter filters the audit records, empty fields are ignored.
+type AuditLogFilter struct {
+	// the actor or the subject of the records
+	AccountID string
+	// the actor of the records
+	ActorID string
+	Action  AuditAction
+}
@@ -4,4 +4,6 @@ type CreateAccountDTO struct {
 	Email    string
 	Username string
 	Password string
+	// The invitation code, required if the registration is invite-only
+	InviteCode string
 }
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// AccountDataExport contains the personal data of the account, which is stored by the service.
+type AccountDataExport struct {
+	ExportedAt    time.Time       `json:"exported_at"`
+	Account       Account         `json:"account"`
+	Sessions      []SessionInfo   `json:"sessions"`
+	SignInHistory []SignInAttempt `json:"sign_in_history"`
+	AuditLog      []AuditRecord   `json:"audit_log"`
+}
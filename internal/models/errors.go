@@ -17,6 +17,8 @@ const (
 	Canceled
 	DeadlineExceeded
 	PermissionDenied
+	ResourceExhausted
+	FailedPrecondition
 )
 
 type ServiceError struct {
@@ -42,6 +44,10 @@ func (t ErrorCode) String() string {
 		return "DeadlineExceeded"
 	case PermissionDenied:
 		return "PermissionDenied"
+	case ResourceExhausted:
+		return "ResourceExhausted"
+	case FailedPrecondition:
+		return "FailedPrecondition"
 	default:
 		return "Unknown"
 	}
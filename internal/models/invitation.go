@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// Invitation permits the registration in the invite-only mode, the invitation code itself is never stored.
+type Invitation struct {
+	ID string
+	// The email the invitation is bound to, any email can be registered with the invitation if empty
+	Email   string
+	MaxUses int
+	Uses    int
+	// The id of the administrator, who created the invitation, empty if the account is deleted
+	CreatedBy string
+	CreatedAt time.Time
+	// zero value means that invitation never expires
+	ExpiresAt time.Time
+}
+
+// IsUsable checks whether the invitation isn't expired and isn't used up.
+func (i Invitation) IsUsable(now time.Time) bool {
+	return i.Uses < i.MaxUses && (i.ExpiresAt.IsZero() || i.ExpiresAt.After(now))
+}
+
+type CreateInvitationDTO struct {
+	Email string
+	// zero value means that the invitation can be used once
+	MaxUses int
+	// zero value means that invitation never expires
+	ExpiresAt time.Time
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInvitationIsUsable(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name       string
+		invitation Invitation
+		usable     bool
+	}{
+		{"unused", Invitation{MaxUses: 1}, true},
+		{"partially used", Invitation{MaxUses: 3, Uses: 2}, true},
+		{"used up", Invitation{MaxUses: 3, Uses: 3}, false},
+		{"not expired", Invitation{MaxUses: 1, ExpiresAt: now.Add(time.Second)}, true},
+		{"expires now", Invitation{MaxUses: 1, ExpiresAt: now}, false},
+		{"expired", Invitation{MaxUses: 1, ExpiresAt: now.Add(-time.Hour)}, false},
+	}
+	for _, c := range cases {
+		if usable := c.invitation.IsUsable(now); usable != c.usable {
+			t.Errorf("%s: usable %v, expected %v", c.name, usable, c.usable)
+		}
+	}
+}
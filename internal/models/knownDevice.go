@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// DeviceRecognition describes whether the device used for the sign in was seen before for the account.
+type DeviceRecognition struct {
+	MachineKnown bool `db:"machine_known"`
+	IPRangeKnown bool `db:"ip_range_known"`
+	// false if the account has never signed in before
+	HasKnownDevices bool `db:"has_known_devices"`
+}
+
+type NewDeviceSignInDTO struct {
+	AccountID  string    `json:"account_id"`
+	Email      string    `json:"email"`
+	ClientIP   string    `json:"client_ip"`
+	MachineID  string    `json:"machine_id"`
+	UserAgent  string    `json:"user_agent,omitempty"`
+	DeviceName string    `json:"device_name,omitempty"`
+	Location   Location  `json:"location"`
+	SignInTime time.Time `json:"sign_in_time"`
+}
@@ -0,0 +1,7 @@
+package models
+
+// Location represents the approximate location of the client resolved by the ip address.
+type Location struct {
+	Country string `json:"country,omitempty"`
+	City    string `json:"city,omitempty"`
+}
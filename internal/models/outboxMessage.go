@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// OutboxMessage is the event stored in the transactional outbox until it's published to the broker.
+type OutboxMessage struct {
+	ID        int64
+	Topic     string
+	Key       string
+	Payload   []byte
+	Headers   map[string]string
+	CreatedAt time.Time
+}
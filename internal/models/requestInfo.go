@@ -0,0 +1,24 @@
+package models
+
+import "context"
+
+// RequestInfo describes the request, which caused the operation.
+type RequestInfo struct {
+	ClientIP  string
+	RequestID string
+	// The preferred language of the client from the Accept-Language header, e.g. en-US
+	Locale string
+}
+
+type requestInfoKey struct{}
+
+// ContextWithRequestInfo returns a copy of the context with the request info.
+func ContextWithRequestInfo(ctx context.Context, info RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, info)
+}
+
+// RequestInfoFromContext returns the request info stored in the context or an empty info.
+func RequestInfoFromContext(ctx context.Context) RequestInfo {
+	info, _ := ctx.Value(requestInfoKey{}).(RequestInfo)
+	return info
+}
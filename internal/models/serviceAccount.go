@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+type AccountType string
+
+const (
+	UserAccountType    AccountType = "user"
+	ServiceAccountType AccountType = "service"
+)
+
+// ServiceAccount represents the non-human identity owned by an administrator.
+type ServiceAccount struct {
+	ID        string    `db:"id"`
+	OwnerID   string    `db:"owner_id"`
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// ServiceAccountCredentials represents the client credentials of the service account,
+// the client secret itself is never stored.
+type ServiceAccountCredentials struct {
+	ClientID         string    `db:"client_id"`
+	ServiceAccountID string    `db:"service_account_id"`
+	SecretHash       string    `db:"secret_hash"`
+	CreatedAt        time.Time `db:"created_at"`
+}
+
+// TokenSubject represents the account authenticated by a bearer token.
+type TokenSubject struct {
+	AccountID   string
+	AccountType AccountType
+	// scopes of the personal access token, empty for service accounts
+	Scopes []string
+}
@@ -2,10 +2,27 @@ package models
 
 import "time"
 
+type SessionTerminationReason string
+
+const (
+	LogoutTerminationReason     SessionTerminationReason = "logout"
+	TerminatedTerminationReason SessionTerminationReason = "terminated"
+	ExpiredTerminationReason    SessionTerminationReason = "expired"
+)
+
 type Session struct {
 	SessionID    string    `json:"session_id"`
 	AccountID    string    `json:"account_id"`
 	MachineID    string    `json:"machine_id"`
 	ClientIP     string    `json:"client_ip"`
 	LastActivity time.Time `json:"last_activity"`
+	UserAgent    string    `json:"user_agent,omitempty"`
+	DeviceName   string    `json:"device_name,omitempty"`
+	Location     Location  `json:"location"`
+	CreatedAt    time.Time `json:"created_at"`
+	// the time when the user last entered the password in the session
+	AuthenticatedAt time.Time `json:"authenticated_at"`
+	// whether the session uses the longer idle timeout
+	RememberMe bool   `json:"remember_me,omitempty"`
+	ClientType string `json:"client_type,omitempty"`
 }
@@ -6,4 +6,8 @@ type SessionInfo struct {
 	ClientIP     string    `json:"client_ip"`
 	MachineID    string    `json:"machine_id"`
 	LastActivity time.Time `json:"last_activity"`
+	UserAgent    string    `json:"user_agent"`
+	DeviceName   string    `json:"device_name"`
+	Location     Location  `json:"location"`
+	ClientType   string    `json:"client_type"`
 }
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+type SignInFailureReason string
+
+const (
+	InvalidPasswordFailureReason        SignInFailureReason = "invalid_password"
+	SessionsLimitExceededFailureReason  SignInFailureReason = "sessions_limit_exceeded"
+	AccountPendingDeletionFailureReason SignInFailureReason = "account_pending_deletion"
+	AccountSuspendedFailureReason       SignInFailureReason = "account_suspended"
+	// The invalid password was entered to confirm the sensitive operation in the signed in session.
+	PasswordVerificationFailureReason SignInFailureReason = "password_verification_failed"
+)
+
+// SignInAttempt is the record of the sign in history of the account.
+type SignInAttempt struct {
+	ID            int64               `db:"id" json:"id"`
+	AccountID     string              `db:"account_id" json:"account_id"`
+	AttemptedAt   time.Time           `db:"attempted_at" json:"attempted_at"`
+	ClientIP      string              `db:"client_ip" json:"client_ip"`
+	MachineID     string              `db:"machine_id" json:"machine_id"`
+	UserAgent     string              `db:"user_agent" json:"user_agent"`
+	Succeeded     bool                `db:"succeeded" json:"succeeded"`
+	FailureReason SignInFailureReason `db:"failure_reason" json:"failure_reason,omitempty"`
+}
@@ -1,8 +1,12 @@
 package models
 
 type SignInDTO struct {
-	Email     string
-	Password  string
-	ClientIP  string
-	MachineID string
+	Email      string
+	Password   string
+	ClientIP   string
+	MachineID  string
+	UserAgent  string
+	DeviceName string
+	RememberMe bool
+	ClientType string
 }
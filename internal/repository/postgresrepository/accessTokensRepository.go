@@ -0,0 +1,142 @@
+package postgresrepository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	accessTokensTableName = "access_tokens"
+)
+
+type AccessTokensRepository struct {
+	db     *sqlx.DB
+	logger *logrus.Logger
+}
+
+// NewAccessTokensRepository creates a new instance of the AccessTokensRepository using the provided database connection.
+func NewAccessTokensRepository(db *sqlx.DB, logger *logrus.Logger) *AccessTokensRepository {
+	return &AccessTokensRepository{db: db, logger: logger}
+}
+
+// accessToken is the database representation of the models.AccessToken,
+// scopes are stored as a space-separated string.
+type accessToken struct {
+	ID         string       `db:"id"`
+	AccountID  string       `db:"account_id"`
+	Name       string       `db:"name"`
+	Scopes     string       `db:"scopes"`
+	CreatedAt  time.Time    `db:"created_at"`
+	ExpiresAt  sql.NullTime `db:"expires_at"`
+	LastUsedAt sql.NullTime `db:"last_used_at"`
+}
+
+func (t accessToken) toModel() models.AccessToken {
+	return models.AccessToken{
+		ID:         t.ID,
+		AccountID:  t.AccountID,
+		Name:       t.Name,
+		Scopes:     strings.Fields(t.Scopes),
+		CreatedAt:  t.CreatedAt.UTC(),
+		ExpiresAt:  t.ExpiresAt.Time.UTC(),
+		LastUsedAt: t.LastUsedAt.Time.UTC(),
+	}
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+const accessTokenColumns = "id, account_id, name, scopes, created_at, expires_at, last_used_at"
+
+// CreateAccessToken stores the access token with the given token hash and returns the id of the token.
+func (r *AccessTokensRepository) CreateAccessToken(ctx context.Context,
+	token models.AccessToken, tokenHash string) (id string, err error) {
+	defer r.handleError(ctx, &err, "CreateAccessToken")
+
+	query := fmt.Sprintf(`INSERT INTO %s (account_id, name, token_hash, scopes, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id;`, accessTokensTableName)
+	err = r.db.GetContext(ctx, &id, query, token.AccountID, token.Name, tokenHash,
+		strings.Join(token.Scopes, " "), token.CreatedAt, nullTime(token.ExpiresAt))
+	if isUniqueViolation(err) {
+		err = models.Error(models.Conflict, "access token with this name already exists")
+	}
+	return
+}
+
+// GetAccessTokens returns all access tokens of the account ordered by creation time.
+func (r *AccessTokensRepository) GetAccessTokens(ctx context.Context, accountID string) (tokens []models.AccessToken, err error) {
+	defer r.handleError(ctx, &err, "GetAccessTokens")
+
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE account_id=$1 ORDER BY created_at;",
+		accessTokenColumns, accessTokensTableName)
+	var rows []accessToken
+	err = r.db.SelectContext(ctx, &rows, query, accountID)
+	if err != nil {
+		return
+	}
+
+	tokens = make([]models.AccessToken, len(rows))
+	for i := range rows {
+		tokens[i] = rows[i].toModel()
+	}
+	return
+}
+
+// CountAccessTokens returns the number of access tokens of the account.
+func (r *AccessTokensRepository) CountAccessTokens(ctx context.Context, accountID string) (count int, err error) {
+	defer r.handleError(ctx, &err, "CountAccessTokens")
+
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE account_id=$1;", accessTokensTableName)
+	err = r.db.GetContext(ctx, &count, query, accountID)
+	return
+}
+
+// RevokeAccessToken deletes the access token with the given id that belongs to the account.
+func (r *AccessTokensRepository) RevokeAccessToken(ctx context.Context, accountID, tokenID string) (err error) {
+	defer r.handleError(ctx, &err, "RevokeAccessToken")
+
+	query := fmt.Sprintf("DELETE FROM %s WHERE id=$1 AND account_id=$2;", accessTokensTableName)
+	res, err := r.db.ExecContext(ctx, query, tokenID, accountID)
+	if err != nil {
+		return
+	}
+
+	num, err := res.RowsAffected()
+	if err != nil {
+		return
+	}
+	if num == 0 {
+		err = sql.ErrNoRows
+	}
+	return
+}
+
+// UseAccessToken finds the unexpired access token of the active account by its hash and updates its last usage time.
+func (r *AccessTokensRepository) UseAccessToken(ctx context.Context,
+	tokenHash string, usageTime time.Time) (token models.AccessToken, err error) {
+	defer r.handleError(ctx, &err, "UseAccessToken")
+
+	query := fmt.Sprintf(`UPDATE %s SET last_used_at=$2
+		WHERE token_hash=$1 AND (expires_at IS NULL OR expires_at > $2)
+		AND account_id IN (SELECT id FROM %s WHERE purge_at IS NULL AND suspended_at IS NULL)
+		RETURNING %s;`, accessTokensTableName, accountTableName, accessTokenColumns)
+	var row accessToken
+	err = r.db.GetContext(ctx, &row, query, tokenHash, usageTime)
+	if err != nil {
+		return
+	}
+
+	return row.toModel(), nil
+}
+
+func (r *AccessTokensRepository) handleError(ctx context.Context, err *error, functionName string) {
+	handleRepositoryError(ctx, err, r.logger, "access tokens", functionName, "access token not found")
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Falokut/accounts_service/internal/models"
 	"github.com/Falokut/accounts_service/internal/repository"
@@ -22,6 +23,41 @@ type AccountsRepository struct {
 	logger *logrus.Logger
 }
 
+// account is the database representation of the models.Account.
+type account struct {
+	ID                 string       `db:"id"`
+	Email              string       `db:"email"`
+	Password           string       `db:"password_hash"`
+	RegistrationDate   time.Time    `db:"registration_date"`
+	IsAdmin            bool         `db:"is_admin"`
+	PurgeAt            sql.NullTime `db:"purge_at"`
+	SuspendedAt        sql.NullTime `db:"suspended_at"`
+	SuspensionReason   string       `db:"suspension_reason"`
+	EmailUndeliverable bool         `db:"email_undeliverable"`
+}
+
+func (a account) toModel() models.Account {
+	res := models.Account{
+		ID:                 a.ID,
+		Email:              a.Email,
+		Password:           a.Password,
+		RegistrationDate:   a.RegistrationDate,
+		IsAdmin:            a.IsAdmin,
+		SuspensionReason:   a.SuspensionReason,
+		EmailUndeliverable: a.EmailUndeliverable,
+	}
+	if a.PurgeAt.Valid {
+		res.PurgeAt = a.PurgeAt.Time.UTC()
+	}
+	if a.SuspendedAt.Valid {
+		res.SuspendedAt = a.SuspendedAt.Time.UTC()
+	}
+	return res
+}
+
+const accountColumns = "id, email, password_hash, registration_date, is_admin, purge_at, " +
+	"suspended_at, suspension_reason, email_undeliverable"
+
 // NewPostgreDB creates a new connection to the PostgreSQL database.
 func NewPostgreDB(cfg *repository.DBConfig) (*sqlx.DB, error) {
 	db, err := sqlx.Connect("pgx", fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
@@ -73,7 +109,7 @@ func (r *AccountsRepository) CreateAccount(ctx context.Context,
 // IsAccountWithEmailExist checks if an account with the given email exists in the database.
 // It returns a boolean indicating the existence and an error, if any.
 func (r *AccountsRepository) IsAccountWithEmailExist(ctx context.Context, email string) (exist bool, err error) {
-	query := fmt.Sprintf("SELECT id FROM %s WHERE email=$1 LIMIT 1;", accountTableName)
+	query := fmt.Sprintf("SELECT id FROM %s WHERE lower(email)=lower($1) LIMIT 1;", accountTableName)
 
 	var id string
 	err = r.db.GetContext(ctx, &id, query, email)
@@ -90,13 +126,17 @@ func (r *AccountsRepository) IsAccountWithEmailExist(ctx context.Context, email
 
 // GetAccountByEmail retrieves a account from the database based on the provided email.
 // It returns the retrieved account and an error, if any.
-func (r *AccountsRepository) GetAccountByEmail(ctx context.Context, email string) (account models.Account, err error) {
+func (r *AccountsRepository) GetAccountByEmail(ctx context.Context, email string) (res models.Account, err error) {
 	defer r.handleError(ctx, &err, "GetAccountByEmail")
 
-	query := fmt.Sprintf("SELECT * FROM %s WHERE email=$1 LIMIT 1;", accountTableName)
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE lower(email)=lower($1) LIMIT 1;", accountColumns, accountTableName)
 
-	err = r.db.GetContext(ctx, &account, query, email)
-	return
+	var row account
+	err = r.db.GetContext(ctx, &row, query, email)
+	if err != nil {
+		return
+	}
+	return row.toModel(), nil
 }
 
 // ChangePassword updates the password hash of an account with the given email in the database.
@@ -104,7 +144,7 @@ func (r *AccountsRepository) GetAccountByEmail(ctx context.Context, email string
 func (r *AccountsRepository) ChangePassword(ctx context.Context, email, passwordHash string) (err error) {
 	defer r.handleError(ctx, &err, "ChangePassword")
 
-	query := fmt.Sprintf("UPDATE %s SET password_hash=$1 WHERE email=$2;", accountTableName)
+	query := fmt.Sprintf("UPDATE %s SET password_hash=$1 WHERE lower(email)=lower($2);", accountTableName)
 
 	res, err := r.db.ExecContext(ctx, query, passwordHash, email)
 	if err != nil {
@@ -150,6 +190,156 @@ func (r *AccountsRepository) GetAccountEmail(ctx context.Context, accountID stri
 	return
 }
 
+// IsAccountAdmin checks if the account with the given id is an administrator.
+func (r *AccountsRepository) IsAccountAdmin(ctx context.Context, accountID string) (isAdmin bool, err error) {
+	defer r.handleError(ctx, &err, "IsAccountAdmin")
+
+	query := fmt.Sprintf("SELECT is_admin FROM %s WHERE id=$1", accountTableName)
+	err = r.db.GetContext(ctx, &isAdmin, query, accountID)
+	return
+}
+
+// GetActiveAccounts returns the accounts with the id greater than afterID ordered by the id,
+// the accounts scheduled for deletion and the suspended accounts are skipped.
+// All accounts are considered if afterID is empty.
+func (r *AccountsRepository) GetActiveAccounts(ctx context.Context,
+	afterID string, limit int) (accounts []models.Account, err error) {
+	defer r.handleError(ctx, &err, "GetActiveAccounts")
+
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE ($1='' OR id>$1::uuid) AND purge_at IS NULL AND suspended_at IS NULL
+		ORDER BY id LIMIT $2;`, accountColumns, accountTableName)
+	return r.selectAccounts(ctx, query, afterID, limit)
+}
+
+// ScheduleAccountDeletion marks the active account as pending deletion until the purge time.
+func (r *AccountsRepository) ScheduleAccountDeletion(ctx context.Context, accountID string, purgeAt time.Time) (err error) {
+	defer r.handleError(ctx, &err, "ScheduleAccountDeletion")
+
+	query := fmt.Sprintf("UPDATE %s SET purge_at=$2 WHERE id=$1 AND purge_at IS NULL;", accountTableName)
+	res, err := r.db.ExecContext(ctx, query, accountID, purgeAt)
+	if err != nil {
+		return
+	}
+
+	num, err := res.RowsAffected()
+	if err != nil {
+		return
+	}
+	if num == 0 {
+		err = models.Error(models.FailedPrecondition, "account is already scheduled for deletion")
+	}
+	return
+}
+
+// RestoreAccount cancels the deletion of the account scheduled with the purge time.
+func (r *AccountsRepository) RestoreAccount(ctx context.Context, accountID string, purgeAt time.Time) (err error) {
+	defer r.handleError(ctx, &err, "RestoreAccount")
+
+	query := fmt.Sprintf("UPDATE %s SET purge_at=NULL WHERE id=$1 AND purge_at=$2;", accountTableName)
+	res, err := r.db.ExecContext(ctx, query, accountID, purgeAt)
+	if err != nil {
+		return
+	}
+
+	num, err := res.RowsAffected()
+	if err != nil {
+		return
+	}
+	if num == 0 {
+		err = models.Error(models.FailedPrecondition, "account isn't scheduled for deletion")
+	}
+	return
+}
+
+// GetAccountsToPurge returns the accounts pending deletion with the purge time before the time.
+func (r *AccountsRepository) GetAccountsToPurge(ctx context.Context,
+	before time.Time, limit int) (accounts []models.Account, err error) {
+	defer r.handleError(ctx, &err, "GetAccountsToPurge")
+
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE purge_at<=$1 ORDER BY purge_at LIMIT $2;",
+		accountColumns, accountTableName)
+	return r.selectAccounts(ctx, query, before, limit)
+}
+
+// PurgeAccount deletes the account pending deletion with the purge time before the time,
+// the account restored in the meantime isn't deleted and the NotFound error is returned.
+func (r *AccountsRepository) PurgeAccount(ctx context.Context,
+	accountID string, before time.Time) (restx repository.Transaction, err error) {
+	defer r.handleError(ctx, &err, "PurgeAccount")
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return
+	}
+
+	query := fmt.Sprintf("DELETE FROM %s WHERE id=$1 AND purge_at<=$2;", accountTableName)
+	res, err := tx.ExecContext(ctx, query, accountID, before)
+	if err != nil {
+		_ = tx.Rollback()
+		return
+	}
+
+	num, err := res.RowsAffected()
+	if err != nil || num == 0 {
+		_ = tx.Rollback()
+		if err == nil {
+			err = sql.ErrNoRows
+		}
+		return
+	}
+	return tx, nil
+}
+
+func (r *AccountsRepository) selectAccounts(ctx context.Context, query string, args ...any) ([]models.Account, error) {
+	var rows []account
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	accounts := make([]models.Account, len(rows))
+	for i := range rows {
+		accounts[i] = rows[i].toModel()
+	}
+	return accounts, nil
+}
+
+// SuspendAccount suspends the account, the suspension of the suspended account isn't changed.
+func (r *AccountsRepository) SuspendAccount(ctx context.Context,
+	accountID, reason string, suspendedAt time.Time) (err error) {
+	defer r.handleError(ctx, &err, "SuspendAccount")
+
+	query := fmt.Sprintf(`UPDATE %s SET suspended_at=COALESCE(suspended_at, $2),
+		suspension_reason=CASE WHEN suspended_at IS NULL THEN $3 ELSE suspension_reason END
+		WHERE id=$1;`, accountTableName)
+	res, err := r.db.ExecContext(ctx, query, accountID, suspendedAt, reason)
+	if err != nil {
+		return
+	}
+
+	num, err := res.RowsAffected()
+	if err == nil && num == 0 {
+		err = sql.ErrNoRows
+	}
+	return
+}
+
+// MarkEmailUndeliverable marks the email of the account as undeliverable.
+func (r *AccountsRepository) MarkEmailUndeliverable(ctx context.Context, email string) (err error) {
+	defer r.handleError(ctx, &err, "MarkEmailUndeliverable")
+
+	query := fmt.Sprintf("UPDATE %s SET email_undeliverable=true WHERE lower(email)=lower($1);", accountTableName)
+	res, err := r.db.ExecContext(ctx, query, email)
+	if err != nil {
+		return
+	}
+
+	num, err := res.RowsAffected()
+	if err == nil && num == 0 {
+		err = sql.ErrNoRows
+	}
+	return
+}
+
 func (r *AccountsRepository) handleError(ctx context.Context, err *error, functionName string) {
 	if ctx.Err() != nil {
 		var code models.ErrorCode
@@ -0,0 +1,65 @@
+package postgresrepository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/Falokut/accounts_service/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	auditLogTableName = "audit_log"
+)
+
+type AuditLogRepository struct {
+	db     *sqlx.DB
+	logger *logrus.Logger
+}
+
+// NewAuditLogRepository creates a new instance of the AuditLogRepository using the provided database connection.
+func NewAuditLogRepository(db *sqlx.DB, logger *logrus.Logger) *AuditLogRepository {
+	return &AuditLogRepository{db: db, logger: logger}
+}
+
+// AddAuditRecord appends the record to the audit log.
+// If the transaction is not nil, the record is written within it, so it's committed together with the change.
+func (r *AuditLogRepository) AddAuditRecord(ctx context.Context,
+	tx repository.Transaction, record models.AuditRecord) (err error) {
+	defer r.handleError(ctx, &err, "AddAuditRecord")
+
+	query := fmt.Sprintf(`INSERT INTO %s
+		(occurred_at, actor_id, subject_account_id, action, target, client_ip, request_id, result, error)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);`, auditLogTableName)
+
+	execer, err := getExecer(r.db, tx)
+	if err != nil {
+		return
+	}
+
+	_, err = execer.ExecContext(ctx, query, record.OccurredAt, record.ActorID, record.SubjectAccountID,
+		record.Action, record.Target, record.ClientIP, record.RequestID, record.Result, record.Error)
+	return
+}
+
+// GetAuditRecords returns at most limit audit records matching the filter ordered from the newest to the oldest.
+// If beforeID isn't 0, only the records older than the record with this id are returned.
+func (r *AuditLogRepository) GetAuditRecords(ctx context.Context,
+	filter models.AuditLogFilter, beforeID int64, limit int) (records []models.AuditRecord, err error) {
+	defer r.handleError(ctx, &err, "GetAuditRecords")
+
+	query := fmt.Sprintf(`SELECT id, occurred_at, actor_id, subject_account_id, action, target,
+			client_ip, request_id, result, error
+		FROM %s
+		WHERE ($1='' OR actor_id=$1 OR subject_account_id=$1) AND ($5='' OR actor_id=$5)
+			AND ($2='' OR action=$2) AND ($3::bigint=0 OR id<$3)
+		ORDER BY id DESC LIMIT $4;`, auditLogTableName)
+	err = r.db.SelectContext(ctx, &records, query, filter.AccountID, filter.Action, beforeID, limit, filter.ActorID)
+	return
+}
+
+func (r *AuditLogRepository) handleError(ctx context.Context, err *error, functionName string) {
+	handleRepositoryError(ctx, err, r.logger, "audit log", functionName, "audit record not found")
+}
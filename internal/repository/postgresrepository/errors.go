@@ -0,0 +1,75 @@
+package postgresrepository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/sirupsen/logrus"
+)
+
+const uniqueViolationCode = "23505"
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
+
+// handleRepositoryError converts the error into the service error and logs it,
+// notFoundMsg is used as message for the sql.ErrNoRows error.
+func handleRepositoryError(ctx context.Context, err *error, logger *logrus.Logger,
+	repositoryName, functionName, notFoundMsg string) {
+	if ctx.Err() != nil {
+		var code models.ErrorCode
+		switch {
+		case errors.Is(ctx.Err(), context.Canceled):
+			code = models.Canceled
+		case errors.Is(ctx.Err(), context.DeadlineExceeded):
+			code = models.DeadlineExceeded
+		}
+		*err = models.Error(code, ctx.Err().Error())
+		logRepositoryError(*err, logger, repositoryName, functionName)
+		return
+	}
+
+	if err == nil || *err == nil {
+		return
+	}
+
+	logRepositoryError(*err, logger, repositoryName, functionName)
+	var repoErr = &models.ServiceError{}
+	if !errors.As(*err, &repoErr) {
+		switch {
+		case errors.Is(*err, sql.ErrNoRows):
+			*err = models.Error(models.NotFound, notFoundMsg)
+		case *err != nil:
+			*err = models.Error(models.Internal, "repository internal error")
+		}
+	}
+}
+
+func logRepositoryError(err error, logger *logrus.Logger, repositoryName, functionName string) {
+	if err == nil {
+		return
+	}
+
+	var repoErr = &models.ServiceError{}
+	if errors.As(err, &repoErr) {
+		logger.WithFields(
+			logrus.Fields{
+				"error.function.name": functionName,
+				"error.msg":           repoErr.Msg,
+				"error.code":          repoErr.Code,
+			},
+		).Error(repositoryName + " repository error occurred")
+	} else {
+		logger.WithFields(
+			logrus.Fields{
+				"error.function.name": functionName,
+				"error.msg":           err.Error(),
+			},
+		).Error(repositoryName + " repository error occurred")
+	}
+}
@@ -0,0 +1,152 @@
+package postgresrepository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/Falokut/accounts_service/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	invitationsTableName = "invitations"
+)
+
+type InvitationsRepository struct {
+	db     *sqlx.DB
+	logger *logrus.Logger
+}
+
+// NewInvitationsRepository creates a new instance of the InvitationsRepository using the provided database connection.
+func NewInvitationsRepository(db *sqlx.DB, logger *logrus.Logger) *InvitationsRepository {
+	return &InvitationsRepository{db: db, logger: logger}
+}
+
+// invitation is the database representation of the models.Invitation.
+type invitation struct {
+	ID        string         `db:"id"`
+	Email     string         `db:"email"`
+	MaxUses   int            `db:"max_uses"`
+	Uses      int            `db:"uses"`
+	CreatedBy sql.NullString `db:"created_by"`
+	CreatedAt time.Time      `db:"created_at"`
+	ExpiresAt sql.NullTime   `db:"expires_at"`
+}
+
+func (i invitation) toModel() models.Invitation {
+	return models.Invitation{
+		ID:        i.ID,
+		Email:     i.Email,
+		MaxUses:   i.MaxUses,
+		Uses:      i.Uses,
+		CreatedBy: i.CreatedBy.String,
+		CreatedAt: i.CreatedAt.UTC(),
+		ExpiresAt: i.ExpiresAt.Time.UTC(),
+	}
+}
+
+const invitationColumns = "id, email, max_uses, uses, created_by, created_at, expires_at"
+
+// CreateInvitation stores the invitation with the given code hash and returns the id of the invitation.
+func (r *InvitationsRepository) CreateInvitation(ctx context.Context,
+	invitation models.Invitation, codeHash string) (id string, err error) {
+	defer r.handleError(ctx, &err, "CreateInvitation")
+
+	query := fmt.Sprintf(`INSERT INTO %s (code_hash, email, max_uses, created_by, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id;`, invitationsTableName)
+	err = r.db.GetContext(ctx, &id, query, codeHash, invitation.Email, invitation.MaxUses,
+		invitation.CreatedBy, invitation.CreatedAt, nullTime(invitation.ExpiresAt))
+	return
+}
+
+// GetUsableInvitations returns the invitations, which aren't expired at the given time and aren't used up,
+// ordered by creation time.
+func (r *InvitationsRepository) GetUsableInvitations(ctx context.Context,
+	now time.Time) (invitations []models.Invitation, err error) {
+	defer r.handleError(ctx, &err, "GetUsableInvitations")
+
+	query := fmt.Sprintf(`SELECT %s FROM %s
+		WHERE uses < max_uses AND (expires_at IS NULL OR expires_at > $1)
+		ORDER BY created_at;`, invitationColumns, invitationsTableName)
+	var rows []invitation
+	err = r.db.SelectContext(ctx, &rows, query, now)
+	if err != nil {
+		return
+	}
+
+	invitations = make([]models.Invitation, len(rows))
+	for i := range rows {
+		invitations[i] = rows[i].toModel()
+	}
+	return
+}
+
+// GetInvitationByCode retrieves the invitation by the hash of its code.
+func (r *InvitationsRepository) GetInvitationByCode(ctx context.Context, codeHash string) (res models.Invitation, err error) {
+	defer r.handleError(ctx, &err, "GetInvitationByCode")
+
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE code_hash=$1;", invitationColumns, invitationsTableName)
+	var row invitation
+	err = r.db.GetContext(ctx, &row, query, codeHash)
+	if err != nil {
+		return
+	}
+	return row.toModel(), nil
+}
+
+// UseInvitation increments the number of the uses of the invitation within the transaction,
+// the invitation, which is revoked, expired or used up, isn't used.
+func (r *InvitationsRepository) UseInvitation(ctx context.Context,
+	tx repository.Transaction, invitationID string, usedAt time.Time) (err error) {
+	defer r.handleError(ctx, &err, "UseInvitation")
+
+	query := fmt.Sprintf(`UPDATE %s SET uses=uses+1
+		WHERE id=$1 AND uses < max_uses AND (expires_at IS NULL OR expires_at > $2);`, invitationsTableName)
+
+	execer, err := getExecer(r.db, tx)
+	if err != nil {
+		return
+	}
+
+	res, err := execer.ExecContext(ctx, query, invitationID, usedAt)
+	if err != nil {
+		return
+	}
+
+	num, err := res.RowsAffected()
+	if err != nil {
+		return
+	}
+	if num == 0 {
+		err = models.Error(models.FailedPrecondition, "the invitation is revoked, expired or used up")
+	}
+	return
+}
+
+// RevokeInvitation deletes the invitation with the given id.
+func (r *InvitationsRepository) RevokeInvitation(ctx context.Context, invitationID string) (err error) {
+	defer r.handleError(ctx, &err, "RevokeInvitation")
+
+	query := fmt.Sprintf("DELETE FROM %s WHERE id=$1;", invitationsTableName)
+	res, err := r.db.ExecContext(ctx, query, invitationID)
+	if err != nil {
+		return
+	}
+
+	num, err := res.RowsAffected()
+	if err != nil {
+		return
+	}
+	if num == 0 {
+		err = sql.ErrNoRows
+	}
+	return
+}
+
+func (r *InvitationsRepository) handleError(ctx context.Context, err *error, functionName string) {
+	handleRepositoryError(ctx, err, r.logger, "invitations", functionName, "invitation not found")
+}
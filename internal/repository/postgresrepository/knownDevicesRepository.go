@@ -0,0 +1,49 @@
+package postgresrepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	knownDevicesTableName = "known_devices"
+)
+
+type KnownDevicesRepository struct {
+	db     *sqlx.DB
+	logger *logrus.Logger
+}
+
+// NewKnownDevicesRepository creates a new instance of the KnownDevicesRepository using the provided database connection.
+func NewKnownDevicesRepository(db *sqlx.DB, logger *logrus.Logger) *KnownDevicesRepository {
+	return &KnownDevicesRepository{db: db, logger: logger}
+}
+
+// RememberDevice marks the machine id and the ip range as known for the account
+// and returns whether they were known before the call.
+func (r *KnownDevicesRepository) RememberDevice(ctx context.Context,
+	accountID, machineID, ipRange string, seenAt time.Time) (recognition models.DeviceRecognition, err error) {
+	defer r.handleError(ctx, &err, "RememberDevice")
+
+	// All statements of the query see the same snapshot, so the known devices are selected before the upsert.
+	query := fmt.Sprintf(`WITH upsert AS (
+			INSERT INTO %[1]s (account_id, machine_id, ip_range, first_seen_at, last_seen_at)
+			VALUES ($1, $2, $3, $4, $4)
+			ON CONFLICT (account_id, machine_id, ip_range) DO UPDATE SET last_seen_at=EXCLUDED.last_seen_at
+		)
+		SELECT COALESCE(bool_or(machine_id=$2), false) AS machine_known,
+			COALESCE(bool_or(ip_range=$3), false) AS ip_range_known,
+			COUNT(*) > 0 AS has_known_devices
+		FROM %[1]s WHERE account_id=$1;`, knownDevicesTableName)
+	err = r.db.GetContext(ctx, &recognition, query, accountID, machineID, ipRange, seenAt)
+	return
+}
+
+func (r *KnownDevicesRepository) handleError(ctx context.Context, err *error, functionName string) {
+	handleRepositoryError(ctx, err, r.logger, "known devices", functionName, "known device not found")
+}
@@ -0,0 +1,167 @@
+package postgresrepository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/Falokut/accounts_service/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	outboxTableName = "events_outbox"
+	// outboxRelayLockID is the key of the advisory lock, which is held by the relaying replica.
+	outboxRelayLockID = 7256130114
+)
+
+type OutboxRepository struct {
+	db     *sqlx.DB
+	logger *logrus.Logger
+}
+
+// NewOutboxRepository creates a new instance of the OutboxRepository using the provided database connection.
+func NewOutboxRepository(db *sqlx.DB, logger *logrus.Logger) *OutboxRepository {
+	return &OutboxRepository{db: db, logger: logger}
+}
+
+// outboxMessage is the database representation of the models.OutboxMessage,
+// headers are stored as a json object.
+type outboxMessage struct {
+	ID        int64     `db:"id"`
+	Topic     string    `db:"topic"`
+	Key       string    `db:"key"`
+	Payload   []byte    `db:"payload"`
+	Headers   []byte    `db:"headers"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (m outboxMessage) toModel() (models.OutboxMessage, error) {
+	var headers map[string]string
+	if err := json.Unmarshal(m.Headers, &headers); err != nil {
+		return models.OutboxMessage{}, err
+	}
+
+	return models.OutboxMessage{
+		ID:        m.ID,
+		Topic:     m.Topic,
+		Key:       m.Key,
+		Payload:   m.Payload,
+		Headers:   headers,
+		CreatedAt: m.CreatedAt.UTC(),
+	}, nil
+}
+
+// AddMessage stores the message in the outbox within the transaction, so it's sent only if the transaction is committed.
+func (r *OutboxRepository) AddMessage(ctx context.Context, tx repository.Transaction, message models.OutboxMessage) (err error) {
+	defer r.handleError(ctx, &err, "AddMessage")
+
+	execer, err := getExecer(r.db, tx)
+	if err != nil {
+		return
+	}
+
+	headers, err := json.Marshal(message.Headers)
+	if err != nil {
+		return
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (topic, key, payload, headers, created_at) VALUES ($1, $2, $3, $4, $5);",
+		outboxTableName)
+	_, err = execer.ExecContext(ctx, query, message.Topic, message.Key, message.Payload, headers, message.CreatedAt)
+	return
+}
+
+// RelayMessages passes at most limit unsent messages in the order of their creation to the publish function,
+// the first published messages are marked as sent. Only one replica relays the messages at a time,
+// the transaction holds the advisory lock, so the other replicas skip the poll and the messages aren't reordered.
+// If the publish function fails, the error is saved for the first unpublished message and the attempts counter is increased,
+// the message is dead-lettered after maxAttempts attempts, so it doesn't block the next messages, unlimited if maxAttempts isn't positive.
+func (r *OutboxRepository) RelayMessages(ctx context.Context, limit, maxAttempts int,
+	publish func(ctx context.Context, messages []models.OutboxMessage) (int, error)) (sent int, err error) {
+	defer r.handleError(ctx, &err, "RelayMessages")
+
+	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var locked bool
+	if err = tx.GetContext(ctx, &locked, "SELECT pg_try_advisory_xact_lock($1);", outboxRelayLockID); err != nil {
+		return
+	}
+	if !locked {
+		err = tx.Rollback()
+		return
+	}
+
+	query := fmt.Sprintf(`SELECT id, topic, key, payload, headers, created_at FROM %s
+		WHERE sent_at IS NULL AND dead_lettered_at IS NULL ORDER BY id LIMIT $1;`, outboxTableName)
+	var rows []outboxMessage
+	if err = tx.SelectContext(ctx, &rows, query, limit); err != nil || len(rows) == 0 {
+		return
+	}
+
+	messages := make([]models.OutboxMessage, len(rows))
+	for i := range rows {
+		if messages[i], err = rows[i].toModel(); err != nil {
+			return
+		}
+	}
+
+	sent, publishErr := publish(ctx, messages)
+	if sent > 0 {
+		query = fmt.Sprintf("UPDATE %s SET sent_at=$1 WHERE id = ANY($2);", outboxTableName)
+		ids := make([]int64, sent)
+		for i := 0; i < sent; i++ {
+			ids[i] = messages[i].ID
+		}
+		if _, err = tx.ExecContext(ctx, query, time.Now().In(time.UTC), ids); err != nil {
+			return
+		}
+	}
+	if publishErr != nil && sent < len(messages) {
+		query = fmt.Sprintf(`UPDATE %s SET attempts=attempts+1, last_error=$1,
+			dead_lettered_at=CASE WHEN $3::integer > 0 AND attempts+1 >= $3::integer THEN now() END
+			WHERE id=$2 RETURNING dead_lettered_at IS NOT NULL;`, outboxTableName)
+		var deadLettered bool
+		if err = tx.GetContext(ctx, &deadLettered, query, publishErr.Error(), messages[sent].ID,
+			maxAttempts); err != nil {
+			return
+		}
+		if deadLettered {
+			r.logger.Errorf("outbox message %d dead-lettered after %d attempts, error: %s",
+				messages[sent].ID, maxAttempts, publishErr.Error())
+		}
+	}
+
+	err = tx.Commit()
+	return
+}
+
+// DeleteSentMessages deletes the messages sent before the time and returns the number of deleted messages.
+func (r *OutboxRepository) DeleteSentMessages(ctx context.Context, before time.Time) (deleted int64, err error) {
+	defer r.handleError(ctx, &err, "DeleteSentMessages")
+
+	query := fmt.Sprintf("DELETE FROM %s WHERE sent_at < $1;", outboxTableName)
+	res, err := r.db.ExecContext(ctx, query, before)
+	if err != nil {
+		return
+	}
+
+	deleted, err = res.RowsAffected()
+	return
+}
+
+func (r *OutboxRepository) handleError(ctx context.Context, err *error, functionName string) {
+	handleRepositoryError(ctx, err, r.logger, "outbox", functionName, "outbox message not found")
+}
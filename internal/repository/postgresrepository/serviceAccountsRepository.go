@@ -0,0 +1,110 @@
+package postgresrepository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	serviceAccountsTableName           = "service_accounts"
+	serviceAccountCredentialsTableName = "service_account_credentials"
+)
+
+type ServiceAccountsRepository struct {
+	db     *sqlx.DB
+	logger *logrus.Logger
+}
+
+// NewServiceAccountsRepository creates a new instance of the ServiceAccountsRepository using the provided database connection.
+func NewServiceAccountsRepository(db *sqlx.DB, logger *logrus.Logger) *ServiceAccountsRepository {
+	return &ServiceAccountsRepository{db: db, logger: logger}
+}
+
+// CreateServiceAccount creates a new service account and returns its id.
+func (r *ServiceAccountsRepository) CreateServiceAccount(ctx context.Context,
+	account models.ServiceAccount) (id string, err error) {
+	defer r.handleError(ctx, &err, "CreateServiceAccount")
+
+	query := fmt.Sprintf(`INSERT INTO %s (owner_id, name, created_at)
+		VALUES ($1, $2, $3) RETURNING id;`, serviceAccountsTableName)
+	err = r.db.GetContext(ctx, &id, query, account.OwnerID, account.Name, account.CreatedAt)
+	if isUniqueViolation(err) {
+		err = models.Error(models.Conflict, "service account with this name already exists")
+	}
+	return
+}
+
+// GetServiceAccount retrieves the service account with the given id.
+func (r *ServiceAccountsRepository) GetServiceAccount(ctx context.Context, id string) (account models.ServiceAccount, err error) {
+	defer r.handleError(ctx, &err, "GetServiceAccount")
+
+	query := fmt.Sprintf("SELECT id, owner_id, name, created_at FROM %s WHERE id=$1;", serviceAccountsTableName)
+	err = r.db.GetContext(ctx, &account, query, id)
+	return
+}
+
+// GetServiceAccounts returns all service accounts owned by the account ordered by creation time.
+func (r *ServiceAccountsRepository) GetServiceAccounts(ctx context.Context,
+	ownerID string) (accounts []models.ServiceAccount, err error) {
+	defer r.handleError(ctx, &err, "GetServiceAccounts")
+
+	query := fmt.Sprintf("SELECT id, owner_id, name, created_at FROM %s WHERE owner_id=$1 ORDER BY created_at;",
+		serviceAccountsTableName)
+	err = r.db.SelectContext(ctx, &accounts, query, ownerID)
+	return
+}
+
+// CreateCredentials stores the client credentials of the service account.
+func (r *ServiceAccountsRepository) CreateCredentials(ctx context.Context,
+	credentials models.ServiceAccountCredentials) (err error) {
+	defer r.handleError(ctx, &err, "CreateCredentials")
+
+	query := fmt.Sprintf(`INSERT INTO %s (client_id, service_account_id, secret_hash, created_at)
+		VALUES ($1, $2, $3, $4);`, serviceAccountCredentialsTableName)
+	_, err = r.db.ExecContext(ctx, query, credentials.ClientID, credentials.ServiceAccountID,
+		credentials.SecretHash, credentials.CreatedAt)
+	return
+}
+
+// GetCredentials retrieves the client credentials by the client id, if the owner account is active.
+func (r *ServiceAccountsRepository) GetCredentials(ctx context.Context,
+	clientID string) (credentials models.ServiceAccountCredentials, err error) {
+	defer r.handleError(ctx, &err, "GetCredentials")
+
+	query := fmt.Sprintf(`SELECT c.client_id, c.service_account_id, c.secret_hash, c.created_at FROM %s c
+		JOIN %s s ON s.id=c.service_account_id
+		JOIN %s a ON a.id=s.owner_id
+		WHERE c.client_id=$1 AND a.purge_at IS NULL AND a.suspended_at IS NULL;`,
+		serviceAccountCredentialsTableName, serviceAccountsTableName, accountTableName)
+	err = r.db.GetContext(ctx, &credentials, query, clientID)
+	return
+}
+
+// DeleteCredentials deletes the client credentials of the service account.
+func (r *ServiceAccountsRepository) DeleteCredentials(ctx context.Context, serviceAccountID, clientID string) (err error) {
+	defer r.handleError(ctx, &err, "DeleteCredentials")
+
+	query := fmt.Sprintf("DELETE FROM %s WHERE client_id=$1 AND service_account_id=$2;", serviceAccountCredentialsTableName)
+	res, err := r.db.ExecContext(ctx, query, clientID, serviceAccountID)
+	if err != nil {
+		return
+	}
+
+	num, err := res.RowsAffected()
+	if err != nil {
+		return
+	}
+	if num == 0 {
+		err = sql.ErrNoRows
+	}
+	return
+}
+
+func (r *ServiceAccountsRepository) handleError(ctx context.Context, err *error, functionName string) {
+	handleRepositoryError(ctx, err, r.logger, "service accounts", functionName, "service account not found")
+}
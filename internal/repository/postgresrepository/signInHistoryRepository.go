@@ -0,0 +1,78 @@
+package postgresrepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	signInHistoryTableName = "sign_in_history"
+)
+
+type SignInHistoryRepository struct {
+	db     *sqlx.DB
+	logger *logrus.Logger
+}
+
+// NewSignInHistoryRepository creates a new instance of the SignInHistoryRepository using the provided database connection.
+func NewSignInHistoryRepository(db *sqlx.DB, logger *logrus.Logger) *SignInHistoryRepository {
+	return &SignInHistoryRepository{db: db, logger: logger}
+}
+
+// AddSignInAttempt stores the sign in attempt.
+func (r *SignInHistoryRepository) AddSignInAttempt(ctx context.Context, attempt models.SignInAttempt) (err error) {
+	defer r.handleError(ctx, &err, "AddSignInAttempt")
+
+	query := fmt.Sprintf(`INSERT INTO %s
+		(account_id, attempted_at, client_ip, machine_id, user_agent, succeeded, failure_reason)
+		VALUES ($1, $2, $3, $4, $5, $6, $7);`, signInHistoryTableName)
+	_, err = r.db.ExecContext(ctx, query, attempt.AccountID, attempt.AttemptedAt, attempt.ClientIP,
+		attempt.MachineID, attempt.UserAgent, attempt.Succeeded, attempt.FailureReason)
+	return
+}
+
+// GetSignInHistory returns at most limit sign in attempts of the account ordered from the newest to the oldest.
+// If beforeID isn't 0, only the attempts older than the attempt with this id are returned.
+func (r *SignInHistoryRepository) GetSignInHistory(ctx context.Context,
+	accountID string, beforeID int64, limit int) (attempts []models.SignInAttempt, err error) {
+	defer r.handleError(ctx, &err, "GetSignInHistory")
+
+	query := fmt.Sprintf(`SELECT id, account_id, attempted_at, client_ip, machine_id, user_agent, succeeded, failure_reason
+		FROM %s WHERE account_id=$1 AND ($2::bigint=0 OR id<$2) ORDER BY id DESC LIMIT $3;`, signInHistoryTableName)
+	err = r.db.SelectContext(ctx, &attempts, query, accountID, beforeID, limit)
+	return
+}
+
+// CountFailedAttempts returns the number of the failed attempts of the account with the reason since the time.
+func (r *SignInHistoryRepository) CountFailedAttempts(ctx context.Context,
+	accountID string, reason models.SignInFailureReason, since time.Time) (count int, err error) {
+	defer r.handleError(ctx, &err, "CountFailedAttempts")
+
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM %s
+		WHERE account_id=$1 AND NOT succeeded AND failure_reason=$2 AND attempted_at>=$3;`, signInHistoryTableName)
+	err = r.db.GetContext(ctx, &count, query, accountID, reason, since)
+	return
+}
+
+// DeleteSignInHistoryBefore deletes the sign in attempts older than the time and returns the number of deleted attempts.
+func (r *SignInHistoryRepository) DeleteSignInHistoryBefore(ctx context.Context, before time.Time) (deleted int64, err error) {
+	defer r.handleError(ctx, &err, "DeleteSignInHistoryBefore")
+
+	query := fmt.Sprintf("DELETE FROM %s WHERE attempted_at < $1;", signInHistoryTableName)
+	res, err := r.db.ExecContext(ctx, query, before)
+	if err != nil {
+		return
+	}
+
+	deleted, err = res.RowsAffected()
+	return
+}
+
+func (r *SignInHistoryRepository) handleError(ctx context.Context, err *error, functionName string) {
+	handleRepositoryError(ctx, err, r.logger, "sign in history", functionName, "sign in attempt not found")
+}
@@ -0,0 +1,22 @@
+package postgresrepository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/Falokut/accounts_service/internal/repository"
+	"github.com/jmoiron/sqlx"
+)
+
+// getExecer returns the transaction to execute the queries within it or the database, if the transaction is nil.
+func getExecer(db *sqlx.DB, tx repository.Transaction) (sqlx.ExecerContext, error) {
+	if tx == nil {
+		return db, nil
+	}
+
+	sqlTx, ok := tx.(*sql.Tx)
+	if !ok {
+		return nil, errors.New("unsupported transaction type")
+	}
+	return sqlTx, nil
+}
@@ -0,0 +1,136 @@
+package redisrepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+)
+
+type DataExportsRepository struct {
+	rdb     *redis.Client
+	logger  *logrus.Logger
+	metrics Metrics
+}
+
+// NewDataExportsRepository creates a new repository of the prepared account data exports.
+func NewDataExportsRepository(opt *redis.Options, logger *logrus.Logger, metrics Metrics) (*DataExportsRepository, error) {
+	logger.Info("Creating data exports repository client")
+	rdb, err := NewRedisClient(opt)
+	if err != nil {
+		return nil, err
+	}
+	return &DataExportsRepository{
+		rdb:     rdb,
+		logger:  logger,
+		metrics: metrics,
+	}, nil
+}
+
+func (r *DataExportsRepository) PingContext(ctx context.Context) error {
+	if err := r.rdb.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("error while pinging data exports repository: %w", err)
+	}
+	return nil
+}
+
+// Shutdown gracefully shuts down the data exports repository.
+func (r *DataExportsRepository) Shutdown() {
+	r.logger.Info("Data exports repository shutting down")
+	err := r.rdb.Close()
+	if err != nil {
+		r.logger.Errorf("error while shutting down data exports repository %v", err)
+	}
+}
+
+// getKeyForDataExport returns the key of the export, the key contains the account id,
+// so the export can be read only for its account.
+func getKeyForDataExport(accountID, exportID string) string {
+	return "data_export_" + accountID + "_" + exportID
+}
+
+func getKeyForDataExportCooldown(accountID string) string {
+	return "data_export_cooldown_" + accountID
+}
+
+// SetDataExport stores the export of the account with the specified ttl.
+func (r *DataExportsRepository) SetDataExport(ctx context.Context,
+	accountID, exportID string, data []byte, ttl time.Duration) (err error) {
+	defer r.updateMetrics(&err, "SetDataExport")
+	defer handleError(ctx, &err)
+	defer r.logError(&err, "SetDataExport")
+
+	err = r.rdb.Set(ctx, getKeyForDataExport(accountID, exportID), data, ttl).Err()
+	return
+}
+
+// GetDataExport returns the stored export of the account, the NotFound error is returned if the export is expired.
+func (r *DataExportsRepository) GetDataExport(ctx context.Context, accountID, exportID string) (data []byte, err error) {
+	defer r.updateMetrics(&err, "GetDataExport")
+	defer handleError(ctx, &err)
+	defer r.logError(&err, "GetDataExport")
+
+	data, err = r.rdb.Get(ctx, getKeyForDataExport(accountID, exportID)).Bytes()
+	return
+}
+
+// StartDataExportCooldown starts the cooldown of the account exports, if it isn't started yet,
+// and returns false if the cooldown is already active.
+func (r *DataExportsRepository) StartDataExportCooldown(ctx context.Context,
+	accountID string, cooldown time.Duration) (started bool, err error) {
+	defer r.updateMetrics(&err, "StartDataExportCooldown")
+	defer handleError(ctx, &err)
+	defer r.logError(&err, "StartDataExportCooldown")
+
+	started, err = r.rdb.SetNX(ctx, getKeyForDataExportCooldown(accountID), time.Now().Unix(), cooldown).Result()
+	return
+}
+
+// ClearDataExportCooldown ends the cooldown of the account exports.
+func (r *DataExportsRepository) ClearDataExportCooldown(ctx context.Context, accountID string) (err error) {
+	defer r.updateMetrics(&err, "ClearDataExportCooldown")
+	defer handleError(ctx, &err)
+	defer r.logError(&err, "ClearDataExportCooldown")
+
+	err = r.rdb.Del(ctx, getKeyForDataExportCooldown(accountID)).Err()
+	return
+}
+
+func (r *DataExportsRepository) logError(errptr *error, functionName string) {
+	if errptr == nil || *errptr == nil {
+		return
+	}
+
+	err := *errptr
+	var repoErr = &models.ServiceError{}
+	if errors.As(err, &repoErr) {
+		r.logger.WithFields(
+			logrus.Fields{
+				"error.function.name": functionName,
+				"error.msg":           repoErr.Msg,
+				"error.code":          repoErr.Code,
+			},
+		).Error("data exports repository error occurred")
+	} else {
+		r.logger.WithFields(
+			logrus.Fields{
+				"error.function.name": functionName,
+				"error.msg":           err.Error(),
+			},
+		).Error("data exports repository error occurred")
+	}
+}
+
+func (r *DataExportsRepository) updateMetrics(errptr *error, functionName string) {
+	if errptr == nil || *errptr == nil {
+		r.metrics.IncCacheHits(functionName)
+		return
+	}
+	if models.Code(*errptr) == models.NotFound {
+		r.metrics.IncCacheMiss(functionName)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Falokut/accounts_service/internal/models"
@@ -49,12 +50,17 @@ func (r *RegistrationRepository) Shutdown() {
 	}
 }
 
+// getRegistrationKey returns the key of the registered account, the emails are case-insensitive.
+func getRegistrationKey(email string) string {
+	return strings.ToLower(email)
+}
+
 // IsAccountExist checks if the provided email account is present in the repository.
 func (r *RegistrationRepository) IsAccountExist(ctx context.Context, email string) (inCache bool, err error) {
 	defer r.updateMetrics(err, "IsAccountExist")
 	defer handleError(ctx, &err)
 	defer r.logError(err, "IsAccountExist")
-	num, err := r.rdb.Exists(ctx, email).Result()
+	num, err := r.rdb.Exists(ctx, getRegistrationKey(email)).Result()
 	if err != nil {
 		return
 	}
@@ -76,7 +82,7 @@ func (r *RegistrationRepository) SetAccount(ctx context.Context,
 		return
 	}
 
-	_, err = r.rdb.Set(ctx, email, serialized, ttl).Result()
+	_, err = r.rdb.Set(ctx, getRegistrationKey(email), serialized, ttl).Result()
 	return nil
 }
 
@@ -87,7 +93,7 @@ func (r *RegistrationRepository) GetAccount(ctx context.Context, email string) (
 	defer handleError(ctx, &err)
 	defer r.logError(err, "GetAccount")
 
-	body, err := r.rdb.Get(ctx, email).Bytes()
+	body, err := r.rdb.Get(ctx, getRegistrationKey(email)).Bytes()
 	if err != nil {
 		return
 	}
@@ -101,7 +107,7 @@ func (r *RegistrationRepository) DeleteAccount(ctx context.Context, email string
 	defer handleError(ctx, &err)
 	defer r.logError(err, "DeleteAccount")
 
-	err = r.rdb.Del(ctx, email).Err()
+	err = r.rdb.Del(ctx, getRegistrationKey(email)).Err()
 	return
 }
 
@@ -127,7 +127,12 @@ func (r *SessionsRepository) SetSession(ctx context.Context, session *models.Ses
 	if err != nil {
 		return
 	}
-	err = tx.Expire(ctx, listKey, ttl).Err()
+	// Sessions of the account may have different ttl, so the ttl of the list is only extended.
+	err = tx.ExpireNX(ctx, listKey, ttl).Err()
+	if err != nil {
+		return
+	}
+	err = tx.ExpireGT(ctx, listKey, ttl).Err()
 	if err != nil {
 		return
 	}
@@ -162,6 +167,36 @@ func (r *SessionsRepository) TerminateSessions(ctx context.Context, sessionsIds
 	return
 }
 
+// terminateOtherSessionsScript deletes all sessions from the account sessions list except the one passed in ARGV[1]
+// and returns the ids of the deleted sessions.
+// The script is executed atomically, so sessions created concurrently are either terminated or created after it.
+// The sessions keys are not declared in KEYS, so the script isn't compatible with the redis cluster.
+var terminateOtherSessionsScript = redis.NewScript(`
+local ids = redis.call('SMEMBERS', KEYS[1])
+local terminated = {}
+for _, id in ipairs(ids) do
+	if id ~= ARGV[1] then
+		redis.call('DEL', id)
+		redis.call('SREM', KEYS[1], id)
+		table.insert(terminated, id)
+	end
+end
+return terminated
+`)
+
+// TerminateOtherSessions terminates all sessions of the account except the session with the sessionID
+// and returns the ids of the terminated sessions.
+func (r *SessionsRepository) TerminateOtherSessions(ctx context.Context,
+	accountID, sessionID string) (terminated []string, err error) {
+	defer r.updateMetrics(&err, "TerminateOtherSessions")
+	defer handleError(ctx, &err)
+	defer r.logError(&err, "TerminateOtherSessions")
+
+	terminated, err = terminateOtherSessionsScript.Run(ctx, r.rdb,
+		[]string{getKeyForAccountSessionsList(accountID)}, sessionID).StringSlice()
+	return
+}
+
 func (r *SessionsRepository) GetSessionsIds(ctx context.Context, accountID string) (sessionsIds []string, err error) {
 	defer r.updateMetrics(&err, "GetSessionsIds")
 	defer handleError(ctx, &err)
@@ -197,8 +232,48 @@ func (r *SessionsRepository) GetSession(ctx context.Context, sessionID string) (
 	return
 }
 
+// updateSessionFieldsScript sets the fields from ARGV[1] in the session stored in KEYS[1]
+// and extends the ttl of the session and the account sessions list KEYS[2] to ARGV[2] milliseconds.
+// The session isn't written if it doesn't exist, so the terminated sessions aren't restored
+// and the concurrent updates of the other fields aren't lost.
+var updateSessionFieldsScript = redis.NewScript(`
+local session = redis.call('GET', KEYS[1])
+if not session then
+	return 0
+end
+session = cjson.decode(session)
+for field, value in pairs(cjson.decode(ARGV[1])) do
+	session[field] = value
+end
+redis.call('SET', KEYS[1], cjson.encode(session), 'PX', ARGV[2])
+redis.call('SADD', KEYS[2], KEYS[1])
+redis.call('PEXPIRE', KEYS[2], ARGV[2], 'NX')
+redis.call('PEXPIRE', KEYS[2], ARGV[2], 'GT')
+return 1
+`)
+
+// updateSessionFields updates the fields of the existing session, redis.Nil is returned if the session doesn't exist.
+func (r *SessionsRepository) updateSessionFields(ctx context.Context, session *models.Session,
+	fields map[string]any, ttl time.Duration) error {
+	serialized, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	updated, err := updateSessionFieldsScript.Run(ctx, r.rdb,
+		[]string{session.SessionID, getKeyForAccountSessionsList(session.AccountID)},
+		serialized, ttl.Milliseconds()).Int()
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return redis.Nil
+	}
+	return nil
+}
+
 // UpdateLastActivityForSession updates the last activity time for a cached session in the Redis repository.
-// It updates the LastActivity field of the cached session, and then caches the updated session.
+// Only the LastActivity field is updated and only if the session still exists.
 func (r *SessionsRepository) UpdateLastActivityForSession(ctx context.Context,
 	cachedSession *models.Session, lastActivityTime time.Time, ttl time.Duration) (err error) {
 	defer r.updateMetrics(&err, "UpdateLastActivityForSession")
@@ -206,7 +281,24 @@ func (r *SessionsRepository) UpdateLastActivityForSession(ctx context.Context,
 	defer r.logError(&err, "UpdateLastActivityForSession")
 
 	cachedSession.LastActivity = lastActivityTime
-	err = r.SetSession(ctx, cachedSession, ttl)
+	err = r.updateSessionFields(ctx, cachedSession, map[string]any{"last_activity": lastActivityTime}, ttl)
+	return
+}
+
+// UpdateAuthenticationTimeForSession updates the authentication and the last activity time of the session,
+// only if the session still exists.
+func (r *SessionsRepository) UpdateAuthenticationTimeForSession(ctx context.Context,
+	session *models.Session, authenticatedAt time.Time, ttl time.Duration) (err error) {
+	defer r.updateMetrics(&err, "UpdateAuthenticationTimeForSession")
+	defer handleError(ctx, &err)
+	defer r.logError(&err, "UpdateAuthenticationTimeForSession")
+
+	session.AuthenticatedAt = authenticatedAt
+	session.LastActivity = authenticatedAt
+	err = r.updateSessionFields(ctx, session, map[string]any{
+		"authenticated_at": authenticatedAt,
+		"last_activity":    authenticatedAt,
+	}, ttl)
 	return
 }
 
@@ -221,6 +313,9 @@ func (r *SessionsRepository) GetSessionsForAccount(ctx context.Context,
 	if err != nil {
 		return
 	}
+	if len(sessionsIds) == 0 {
+		return map[string]*models.SessionInfo{}, nil
+	}
 
 	sessionsInfo, err := r.rdb.MGet(ctx, sessionsIds...).Result()
 	if err != nil {
@@ -242,6 +337,10 @@ func (r *SessionsRepository) GetSessionsForAccount(ctx context.Context,
 			ClientIP:     repository.ClientIP,
 			MachineID:    repository.MachineID,
 			LastActivity: repository.LastActivity,
+			UserAgent:    repository.UserAgent,
+			DeviceName:   repository.DeviceName,
+			Location:     repository.Location,
+			ClientType:   repository.ClientType,
 		}
 	}
 
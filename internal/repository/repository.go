@@ -33,6 +33,32 @@ type AccountRepository interface {
 
 	// DeleteAccount removes the account with the given id from the database.
 	DeleteAccount(ctx context.Context, id string) (Transaction, error)
+
+	// IsAccountAdmin checks if the account with the given id is an administrator.
+	IsAccountAdmin(ctx context.Context, accountID string) (bool, error)
+
+	// GetActiveAccounts returns the accounts with the id greater than afterID ordered by the id,
+	// the accounts scheduled for deletion and the suspended accounts are skipped.
+	// All accounts are considered if afterID is empty.
+	GetActiveAccounts(ctx context.Context, afterID string, limit int) ([]models.Account, error)
+
+	// ScheduleAccountDeletion marks the active account as pending deletion until the purge time.
+	ScheduleAccountDeletion(ctx context.Context, accountID string, purgeAt time.Time) error
+
+	// RestoreAccount cancels the deletion of the account scheduled with the purge time.
+	RestoreAccount(ctx context.Context, accountID string, purgeAt time.Time) error
+
+	// GetAccountsToPurge returns the accounts pending deletion with the purge time before the time.
+	GetAccountsToPurge(ctx context.Context, before time.Time, limit int) ([]models.Account, error)
+
+	// PurgeAccount deletes the account pending deletion with the purge time before the time within the transaction.
+	PurgeAccount(ctx context.Context, accountID string, before time.Time) (Transaction, error)
+
+	// SuspendAccount suspends the account, the suspension of the suspended account isn't changed.
+	SuspendAccount(ctx context.Context, accountID, reason string, suspendedAt time.Time) error
+
+	// MarkEmailUndeliverable marks the email of the account as undeliverable.
+	MarkEmailUndeliverable(ctx context.Context, email string) error
 }
 
 // RegistrationRepository provides methods to interact with the registration repository.
@@ -64,9 +90,15 @@ type SessionsRepository interface {
 	// TerminateSessions terminates the specified sessions for the given account id.
 	TerminateSessions(ctx context.Context, sessionsID []string, accountID string) error
 
+	// TerminateOtherSessions atomically terminates all sessions of the account except the specified one.
+	TerminateOtherSessions(ctx context.Context, accountID, sessionID string) (terminated []string, err error)
+
 	// UpdateLastActivityForSession updates the last activity time for the session.
 	UpdateLastActivityForSession(ctx context.Context, session *models.Session, lastActivityTime time.Time, ttl time.Duration) error
 
+	// UpdateAuthenticationTimeForSession updates the authentication and the last activity time for the existing session.
+	UpdateAuthenticationTimeForSession(ctx context.Context, session *models.Session, authenticatedAt time.Time, ttl time.Duration) error
+
 	// GetSession retrieves the cached session data for a specific session id.
 	GetSession(ctx context.Context, sessionID string) (models.Session, error)
 
@@ -79,6 +111,142 @@ type SessionsRepository interface {
 	TerminateAllSessions(ctx context.Context, accountID string) error
 }
 
+// DataExportsRepository stores the prepared account data exports until they expire.
+//
+//go:generate mockgen -source=repository.go -destination=mocks/repository.go
+type DataExportsRepository interface {
+	// SetDataExport stores the export of the account with the specified ttl.
+	SetDataExport(ctx context.Context, accountID, exportID string, data []byte, ttl time.Duration) error
+
+	// GetDataExport returns the stored export of the account.
+	GetDataExport(ctx context.Context, accountID, exportID string) ([]byte, error)
+
+	// StartDataExportCooldown starts the cooldown of the account exports and returns false if it's already active.
+	StartDataExportCooldown(ctx context.Context, accountID string, cooldown time.Duration) (bool, error)
+
+	// ClearDataExportCooldown ends the cooldown of the account exports, e.g. if the export isn't prepared.
+	ClearDataExportCooldown(ctx context.Context, accountID string) error
+}
+
+// AccessTokensRepository provides methods to interact with personal access tokens in the database.
+//
+//go:generate mockgen -source=repository.go -destination=mocks/repository.go
+type AccessTokensRepository interface {
+	// CreateAccessToken stores the access token with the given token hash and returns the id of the token.
+	CreateAccessToken(ctx context.Context, token models.AccessToken, tokenHash string) (string, error)
+
+	// GetAccessTokens returns all access tokens of the account.
+	GetAccessTokens(ctx context.Context, accountID string) ([]models.AccessToken, error)
+
+	// CountAccessTokens returns the number of access tokens of the account.
+	CountAccessTokens(ctx context.Context, accountID string) (int, error)
+
+	// RevokeAccessToken deletes the access token with the given id that belongs to the account.
+	RevokeAccessToken(ctx context.Context, accountID, tokenID string) error
+
+	// UseAccessToken finds the unexpired access token of the active account by its hash and updates its last usage time.
+	UseAccessToken(ctx context.Context, tokenHash string, usageTime time.Time) (models.AccessToken, error)
+}
+
+// ServiceAccountsRepository provides methods to interact with service accounts and their credentials in the database.
+//
+//go:generate mockgen -source=repository.go -destination=mocks/repository.go
+type ServiceAccountsRepository interface {
+	// CreateServiceAccount creates a new service account and returns its id.
+	CreateServiceAccount(ctx context.Context, account models.ServiceAccount) (string, error)
+
+	// GetServiceAccount retrieves the service account with the given id.
+	GetServiceAccount(ctx context.Context, id string) (models.ServiceAccount, error)
+
+	// GetServiceAccounts returns all service accounts owned by the account.
+	GetServiceAccounts(ctx context.Context, ownerID string) ([]models.ServiceAccount, error)
+
+	// CreateCredentials stores the client credentials of the service account.
+	CreateCredentials(ctx context.Context, credentials models.ServiceAccountCredentials) error
+
+	// GetCredentials retrieves the client credentials by the client id, if the owner account is active.
+	GetCredentials(ctx context.Context, clientID string) (models.ServiceAccountCredentials, error)
+
+	// DeleteCredentials deletes the client credentials of the service account.
+	DeleteCredentials(ctx context.Context, serviceAccountID, clientID string) error
+}
+
+// InvitationsRepository provides methods to interact with the registration invitations in the database.
+//
+//go:generate mockgen -source=repository.go -destination=mocks/repository.go
+type InvitationsRepository interface {
+	// CreateInvitation stores the invitation with the given code hash and returns the id of the invitation.
+	CreateInvitation(ctx context.Context, invitation models.Invitation, codeHash string) (string, error)
+
+	// GetUsableInvitations returns the invitations, which aren't expired at the given time and aren't used up,
+	// ordered by creation time.
+	GetUsableInvitations(ctx context.Context, now time.Time) ([]models.Invitation, error)
+
+	// GetInvitationByCode retrieves the invitation by the hash of its code.
+	GetInvitationByCode(ctx context.Context, codeHash string) (models.Invitation, error)
+
+	// UseInvitation increments the number of the uses of the usable invitation within the transaction.
+	UseInvitation(ctx context.Context, tx Transaction, invitationID string, usedAt time.Time) error
+
+	// RevokeInvitation deletes the invitation with the given id.
+	RevokeInvitation(ctx context.Context, invitationID string) error
+}
+
+// KnownDevicesRepository provides methods to interact with the devices used for signing in to the accounts.
+//
+//go:generate mockgen -source=repository.go -destination=mocks/repository.go
+type KnownDevicesRepository interface {
+	// RememberDevice marks the machine id and the ip range as known for the account
+	// and returns whether they were known before.
+	RememberDevice(ctx context.Context, accountID, machineID, ipRange string, seenAt time.Time) (models.DeviceRecognition, error)
+}
+
+// SignInHistoryRepository provides methods to interact with the sign in history of the accounts.
+//
+//go:generate mockgen -source=repository.go -destination=mocks/repository.go
+type SignInHistoryRepository interface {
+	// AddSignInAttempt stores the sign in attempt.
+	AddSignInAttempt(ctx context.Context, attempt models.SignInAttempt) error
+
+	// GetSignInHistory returns the sign in attempts of the account older than the attempt with beforeID,
+	// ordered from the newest to the oldest. All attempts are considered if beforeID is 0.
+	GetSignInHistory(ctx context.Context, accountID string, beforeID int64, limit int) ([]models.SignInAttempt, error)
+
+	// CountFailedAttempts returns the number of the failed attempts of the account with the reason since the time.
+	CountFailedAttempts(ctx context.Context, accountID string, reason models.SignInFailureReason, since time.Time) (int, error)
+
+	// DeleteSignInHistoryBefore deletes the sign in attempts older than the time.
+	DeleteSignInHistoryBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// AuditLogRepository provides methods to interact with the append-only security audit log.
+//
+//go:generate mockgen -source=repository.go -destination=mocks/repository.go
+type AuditLogRepository interface {
+	// AddAuditRecord appends the record to the audit log within the transaction, if it's not nil.
+	AddAuditRecord(ctx context.Context, tx Transaction, record models.AuditRecord) error
+
+	// GetAuditRecords returns the audit records matching the filter older than the record with beforeID,
+	// ordered from the newest to the oldest. All records are considered if beforeID is 0.
+	GetAuditRecords(ctx context.Context, filter models.AuditLogFilter, beforeID int64, limit int) ([]models.AuditRecord, error)
+}
+
+// OutboxRepository provides methods to interact with the transactional outbox of the events.
+//
+//go:generate mockgen -source=repository.go -destination=mocks/repository.go
+type OutboxRepository interface {
+	// AddMessage stores the message in the outbox within the transaction.
+	AddMessage(ctx context.Context, tx Transaction, message models.OutboxMessage) error
+
+	// RelayMessages passes the unsent messages to the publish function, which returns the number of published messages,
+	// and marks the published messages as sent. The message is dead-lettered after maxAttempts failed attempts.
+	RelayMessages(ctx context.Context, limit, maxAttempts int,
+		publish func(ctx context.Context, messages []models.OutboxMessage) (int, error)) (int, error)
+
+	// DeleteSentMessages deletes the messages sent before the time.
+	DeleteSentMessages(ctx context.Context, before time.Time) (int64, error)
+}
+
 type DBConfig struct {
 	Host     string `yaml:"host" env:"DB_HOST"`
 	Port     string `yaml:"port" env:"DB_PORT"`
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+)
+
+const (
+	accessTokenPrefix    = "pat_"
+	secretTokenBytesSize = 32
+)
+
+func (s *accountsService) CreateAccessToken(ctx context.Context, sessionID, machineID string,
+	dto models.CreateAccessTokenDTO) (token string, info models.AccessToken, err error) {
+	s.logger.Info("Checking session")
+	session, err := s.checkAndUpdateSession(ctx, machineID, sessionID)
+	if err != nil {
+		return
+	}
+
+	record := models.AuditRecord{
+		ActorID:          session.AccountID,
+		SubjectAccountID: session.AccountID,
+		Action:           models.AccessTokenCreatedAuditAction,
+	}
+	defer func() { s.auditLog(ctx, record, err) }()
+
+	if err = s.requireRecentAuthentication(session); err != nil {
+		return
+	}
+
+	now := time.Now().In(time.UTC)
+	expiresAt := dto.ExpiresAt.In(time.UTC)
+	if s.cfg.AccessTokenMaxTTL > 0 {
+		maxExpiresAt := now.Add(s.cfg.AccessTokenMaxTTL)
+		if dto.ExpiresAt.IsZero() {
+			expiresAt = maxExpiresAt
+		} else if expiresAt.After(maxExpiresAt) {
+			err = models.Errorf(models.InvalidArgument, "token lifetime mustn't exceed %s", s.cfg.AccessTokenMaxTTL)
+			return
+		}
+	}
+	if !dto.ExpiresAt.IsZero() && !expiresAt.After(now) {
+		err = models.Error(models.InvalidArgument, "token expiration time must be in the future")
+		return
+	}
+
+	if s.cfg.MaxAccessTokensPerAccount > 0 {
+		s.logger.Info("Checking access tokens count")
+		var count int
+		count, err = s.accessTokensRepository.CountAccessTokens(ctx, session.AccountID)
+		if err != nil {
+			return
+		}
+		if count >= s.cfg.MaxAccessTokensPerAccount {
+			err = models.Errorf(models.InvalidArgument,
+				"the maximum number of access tokens %d has been reached, revoke unused tokens", s.cfg.MaxAccessTokensPerAccount)
+			return
+		}
+	}
+
+	token, err = generateSecretToken(accessTokenPrefix)
+	if err != nil {
+		err = models.Error(models.Internal, "can't generate access token")
+		return
+	}
+
+	info = models.AccessToken{
+		AccountID: session.AccountID,
+		Name:      dto.Name,
+		Scopes:    dto.Scopes,
+		CreatedAt: now,
+		ExpiresAt: expiresAt,
+	}
+
+	s.logger.Info("Saving access token")
+	info.ID, err = s.accessTokensRepository.CreateAccessToken(ctx, info, hashToken(token))
+	if err != nil {
+		return "", models.AccessToken{}, err
+	}
+
+	record.Target = info.ID
+	return token, info, nil
+}
+
+func (s *accountsService) GetAccessTokens(ctx context.Context,
+	sessionID, machineID string) (tokens []models.AccessToken, err error) {
+	s.logger.Info("Checking session")
+	session, err := s.checkAndUpdateSession(ctx, machineID, sessionID)
+	if err != nil {
+		return
+	}
+
+	tokens, err = s.accessTokensRepository.GetAccessTokens(ctx, session.AccountID)
+	return
+}
+
+func (s *accountsService) RevokeAccessToken(ctx context.Context, sessionID, machineID, tokenID string) (err error) {
+	s.logger.Info("Checking session")
+	session, err := s.checkAndUpdateSession(ctx, machineID, sessionID)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		s.auditLog(ctx, models.AuditRecord{
+			ActorID:          session.AccountID,
+			SubjectAccountID: session.AccountID,
+			Action:           models.AccessTokenRevokedAuditAction,
+			Target:           tokenID,
+		}, err)
+	}()
+
+	s.logger.Info("Revoking access token")
+	err = s.accessTokensRepository.RevokeAccessToken(ctx, session.AccountID, tokenID)
+	return
+}
+
+// GetTokenSubject authenticates the bearer token, the token is either
+// a personal access token or a service account token.
+func (s *accountsService) GetTokenSubject(ctx context.Context, token string) (subject models.TokenSubject, err error) {
+	if !strings.HasPrefix(token, accessTokenPrefix) {
+		return s.getServiceAccountTokenSubject(token)
+	}
+
+	s.logger.Info("Checking access token")
+	accessToken, err := s.accessTokensRepository.UseAccessToken(ctx, hashToken(token), time.Now().In(time.UTC))
+	if models.Code(err) == models.NotFound {
+		err = models.Error(models.Unauthenticated, "invalid or expired access token")
+	}
+	if err != nil {
+		return
+	}
+
+	return models.TokenSubject{
+		AccountID:   accessToken.AccountID,
+		AccountType: models.UserAccountType,
+		Scopes:      accessToken.Scopes,
+	}, nil
+}
+
+// generateSecretToken generates the random token with the prefix,
+// the prefix allows to distinguish the kinds of credentials.
+func generateSecretToken(prefix string) (string, error) {
+	b := make([]byte, secretTokenBytesSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return prefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashToken returns the hex encoded sha256 hash of the token.
+// The token has enough entropy, so a fast hash is sufficient and allows lookups by the hash.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/Falokut/accounts_service/pkg/jwt"
+	"github.com/sirupsen/logrus"
+)
+
+// scheduleAccountDeletion marks the account as pending deletion for the grace period and terminates its sessions,
+// the account is purged by the AccountsPurger when the grace period expires.
+func (s *accountsService) scheduleAccountDeletion(ctx context.Context, accountID string, record models.AuditRecord) error {
+	s.logger.Info("Scheduling account deletion")
+	purgeAt := time.Now().In(time.UTC).Add(s.cfg.AccountDeletionGracePeriod)
+	if err := s.accountsRepository.ScheduleAccountDeletion(ctx, accountID, purgeAt); err != nil {
+		return err
+	}
+
+	s.auditLog(ctx, record, nil)
+	go s.terminateAllSessions(accountID)
+	return nil
+}
+
+func (s *accountsService) RequestAccountRestoreToken(ctx context.Context, email, callbackURL string) (err error) {
+	if email, err = normalizeEmail(email); err != nil {
+		return
+	}
+
+	account, err := s.accountsRepository.GetAccountByEmail(ctx, email)
+	if err != nil {
+		return
+	}
+	if !account.IsPendingDeletion() {
+		return models.Error(models.FailedPrecondition, "account isn't scheduled for deletion")
+	}
+
+	// The token mustn't outlive the account.
+	ttl := s.cfg.RestoreAccountTokenTTL
+	if remaining := time.Until(account.PurgeAt); remaining < ttl {
+		ttl = remaining
+	}
+
+	token, err := jwt.GenerateToken(restoreTokenValue(account.Email, account.PurgeAt),
+		s.cfg.RestoreAccountTokenSecret, ttl)
+	if err != nil {
+		err = models.Error(models.Internal, err.Error())
+		return
+	}
+
+	err = s.tokenDeliveryMQ.RequestAccountRestoreTokenDelivery(ctx, email, token, callbackURL, ttl)
+	return
+}
+
+func (s *accountsService) RestoreAccount(ctx context.Context, token string) (err error) {
+	s.logger.Info("Parsing jwt token")
+	value, err := jwt.ParseToken(token, s.cfg.RestoreAccountTokenSecret)
+	if err != nil {
+		err = models.Error(models.InvalidArgument, err.Error())
+		return
+	}
+	email, purgeAt, err := parseRestoreTokenValue(value)
+	if err != nil {
+		return
+	}
+
+	account, err := s.accountsRepository.GetAccountByEmail(ctx, email)
+	if err != nil {
+		return
+	}
+	if !account.PurgeAt.Equal(purgeAt) {
+		return models.Error(models.FailedPrecondition,
+			"the restore token was issued for another deletion of the account, request a new token")
+	}
+
+	record := models.AuditRecord{
+		ActorID:          account.ID,
+		SubjectAccountID: account.ID,
+		Action:           models.AccountRestoredAuditAction,
+	}
+	defer func() { s.auditLog(ctx, record, err) }()
+
+	s.logger.Info("Restoring account")
+	err = s.accountsRepository.RestoreAccount(ctx, account.ID, purgeAt)
+	return
+}
+
+// restoreTokenValue returns the value of the restore token, which is bound to the deletion with the purge time,
+// so the token is rejected if the account is restored and deleted again.
+func restoreTokenValue(email string, purgeAt time.Time) string {
+	return strconv.FormatInt(purgeAt.UnixMicro(), 10) + ":" + email
+}
+
+func parseRestoreTokenValue(value string) (email string, purgeAt time.Time, err error) {
+	purgeAtMicro, email, found := strings.Cut(value, ":")
+	micro, parseErr := strconv.ParseInt(purgeAtMicro, 10, 64)
+	if !found || parseErr != nil || email == "" {
+		return "", time.Time{}, models.Error(models.InvalidArgument, "invalid restore token")
+	}
+	return email, time.UnixMicro(micro).UTC(), nil
+}
+
+// purgeAccount deletes the account pending deletion and writes the account_deleted event within the transaction.
+func (s *accountsService) purgeAccount(ctx context.Context, account models.Account, before time.Time) error {
+	tx, err := s.accountsRepository.PurgeAccount(ctx, account.ID, before)
+	if err != nil {
+		return err
+	}
+
+	err = s.addAuditRecord(ctx, tx, models.AuditRecord{
+		SubjectAccountID: account.ID,
+		Action:           models.AccountDeletedAuditAction,
+	}, nil)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = s.accountEventsOutbox.AccountDeleted(ctx, tx, account.Email, account.ID)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return models.Error(models.Internal, err.Error())
+	}
+	return nil
+}
+
+const accountsPurgeBatchSize = 100
+
+// AccountsPurger periodically deletes the accounts, which deletion grace period has expired.
+type AccountsPurger struct {
+	service  *accountsService
+	logger   *logrus.Logger
+	interval time.Duration
+}
+
+func NewAccountsPurger(service *accountsService, logger *logrus.Logger, interval time.Duration) *AccountsPurger {
+	return &AccountsPurger{
+		service:  service,
+		logger:   logger,
+		interval: interval,
+	}
+}
+
+// Run purges the accounts until the context is canceled.
+// The purger runs even if the grace period is disabled, so the accounts scheduled before are purged.
+func (p *AccountsPurger) Run(ctx context.Context) {
+	if p.interval <= 0 {
+		p.logger.Info("Accounts purging disabled")
+		return
+	}
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		p.purge(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purge purges the accounts in batches, the failed accounts are retried on the next run.
+func (p *AccountsPurger) purge(ctx context.Context) {
+	now := time.Now().In(time.UTC)
+	for ctx.Err() == nil {
+		accounts, err := p.service.accountsRepository.GetAccountsToPurge(ctx, now, accountsPurgeBatchSize)
+		if err != nil {
+			p.logger.Warning("accounts to purge not fetched, error: ", err.Error())
+			return
+		}
+
+		var failed bool
+		for i := range accounts {
+			err = p.service.purgeAccount(ctx, accounts[i], now)
+			// The account is restored in the meantime.
+			if models.Code(err) == models.NotFound {
+				continue
+			}
+			if err != nil {
+				p.logger.Warningf("account %s not purged, error: %s", accounts[i].ID, err.Error())
+				failed = true
+			}
+		}
+		if len(accounts) > 0 {
+			p.logger.Infof("%d accounts processed for purging", len(accounts))
+		}
+		if failed || len(accounts) < accountsPurgeBatchSize {
+			return
+		}
+	}
+}
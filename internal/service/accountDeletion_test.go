@@ -0,0 +1,27 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+)
+
+func TestRestoreTokenValue(t *testing.T) {
+	purgeAt := time.Date(2024, 3, 1, 12, 30, 0, 123456000, time.UTC)
+	for _, email := range []string{"bob@example.com", `"bob:home"@example.com`} {
+		parsedEmail, parsedPurgeAt, err := parseRestoreTokenValue(restoreTokenValue(email, purgeAt))
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", email, err)
+		}
+		if parsedEmail != email || !parsedPurgeAt.Equal(purgeAt) {
+			t.Errorf("%q: parsed %q %v, expected %q %v", email, parsedEmail, parsedPurgeAt, email, purgeAt)
+		}
+	}
+
+	for _, value := range []string{"bob@example.com", "1709296200123456:", "soon:bob@example.com"} {
+		if _, _, err := parseRestoreTokenValue(value); models.Code(err) != models.InvalidArgument {
+			t.Errorf("%q: expected invalid argument, got %v", value, err)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/Falokut/accounts_service/internal/repository"
+)
+
+func (s *accountsService) QueryAuditLog(ctx context.Context, sessionID, machineID string,
+	filter models.AuditLogFilter, pageSize int, pageToken string) (records []models.AuditRecord, nextPageToken string, err error) {
+	pageSize, beforeID, err := parsePage(pageSize, pageToken)
+	if err != nil {
+		return
+	}
+
+	if _, err = s.checkAdminSession(ctx, machineID, sessionID); err != nil {
+		return
+	}
+
+	records, err = s.auditLogRepository.GetAuditRecords(ctx, filter, beforeID, pageSize)
+	if err != nil {
+		return
+	}
+
+	if len(records) == pageSize {
+		nextPageToken = getPageToken(records[len(records)-1].ID)
+	}
+	return
+}
+
+// auditLog writes the audit record with the result of the operation outside of any transaction,
+// the error is only logged, because the operation is already done.
+// The records without the actor and the subject, e.g. of unauthenticated requests, aren't written.
+func (s *accountsService) auditLog(ctx context.Context, record models.AuditRecord, opErr error) {
+	if record.ActorID == "" && record.SubjectAccountID == "" {
+		return
+	}
+
+	// The record must be written even if the request is canceled.
+	if err := s.addAuditRecord(context.WithoutCancel(ctx), nil, record, opErr); err != nil {
+		s.logger.Error("audit record not written, error: ", err.Error())
+	}
+}
+
+// addAuditRecord fills the result and the request info of the record and writes it within the transaction.
+func (s *accountsService) addAuditRecord(ctx context.Context,
+	tx repository.Transaction, record models.AuditRecord, opErr error) error {
+	info := models.RequestInfoFromContext(ctx)
+	record.OccurredAt = time.Now().In(time.UTC)
+	record.ClientIP = info.ClientIP
+	record.RequestID = info.RequestID
+	record.Result = models.SuccessAuditResult
+	if opErr != nil {
+		record.Result = models.FailureAuditResult
+		record.Error = opErr.Error()
+		var serviceErr = &models.ServiceError{}
+		if errors.As(opErr, &serviceErr) {
+			record.Error = serviceErr.Msg
+		}
+	}
+
+	s.logger.Info("Writing audit record")
+	return s.auditLogRepository.AddAuditRecord(ctx, tx, record)
+}
@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/Falokut/accounts_service/pkg/jwt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// dataExportPageSize is the number of the sign in attempts and the audit records read at once.
+	dataExportPageSize = 500
+	// dataExportQueueSize is the number of the requested exports waiting to be prepared.
+	dataExportQueueSize = 100
+)
+
+// dataExportRequest is the requested export waiting to be prepared by the DataExportWorker.
+type dataExportRequest struct {
+	accountID string
+	// the request info of the export request, which is used in the audit log
+	requestInfo models.RequestInfo
+}
+
+func (s *accountsService) ExportAccountData(ctx context.Context, sessionID, machineID string) (data []byte, err error) {
+	s.logger.Info("Checking session")
+	session, err := s.checkAndUpdateSession(ctx, machineID, sessionID)
+	if err != nil {
+		return
+	}
+
+	record := models.AuditRecord{
+		ActorID:          session.AccountID,
+		SubjectAccountID: session.AccountID,
+		Action:           models.AccountDataExportedAuditAction,
+	}
+	defer func() { s.auditLog(ctx, record, err) }()
+
+	if err = s.requireRecentAuthentication(session); err != nil {
+		return
+	}
+
+	data, err = s.buildDataExport(ctx, session.AccountID)
+	return
+}
+
+// RequestAccountDataExport queues the export, the DataExportWorker prepares it, stores it for the data export ttl
+// and sends the data_export_ready event with the signed download token.
+// The export can be requested once per the cooldown, the cooldown is cleared if the export isn't prepared.
+func (s *accountsService) RequestAccountDataExport(ctx context.Context, sessionID, machineID string) (err error) {
+	s.logger.Info("Checking session")
+	session, err := s.checkAndUpdateSession(ctx, machineID, sessionID)
+	if err != nil {
+		return
+	}
+
+	record := models.AuditRecord{
+		ActorID:          session.AccountID,
+		SubjectAccountID: session.AccountID,
+		Action:           models.AccountDataExportRequestedAuditAction,
+	}
+	defer func() { s.auditLog(ctx, record, err) }()
+
+	if err = s.requireRecentAuthentication(session); err != nil {
+		return
+	}
+
+	if s.cfg.DataExportCooldown > 0 {
+		var started bool
+		started, err = s.dataExportsRepository.StartDataExportCooldown(ctx, session.AccountID, s.cfg.DataExportCooldown)
+		if err != nil {
+			return
+		}
+		if !started {
+			err = models.Error(models.ResourceExhausted, "the data export has been requested recently, try again later")
+			return
+		}
+	}
+
+	request := dataExportRequest{accountID: session.AccountID, requestInfo: models.RequestInfoFromContext(ctx)}
+	select {
+	case s.dataExportRequests <- request:
+		return nil
+	default:
+		s.clearDataExportCooldown(ctx, session.AccountID)
+		err = models.Error(models.ResourceExhausted, "too many data exports are being prepared, try again later")
+		return
+	}
+}
+
+// prepareDataExport prepares and stores the export and sends the data_export_ready event,
+// the cooldown is cleared if the export isn't prepared, so it can be requested again.
+func (s *accountsService) prepareDataExport(ctx context.Context, request dataExportRequest) {
+	ctx = models.ContextWithRequestInfo(ctx, request.requestInfo)
+	accountID := request.accountID
+	record := models.AuditRecord{
+		ActorID:          accountID,
+		SubjectAccountID: accountID,
+		Action:           models.AccountDataExportedAuditAction,
+	}
+
+	var err error
+	defer func() {
+		s.auditLog(ctx, record, err)
+		if err != nil {
+			s.clearDataExportCooldown(ctx, accountID)
+		}
+	}()
+
+	s.logger.Info("Preparing data export")
+	data, err := s.buildDataExport(ctx, accountID)
+	if err != nil {
+		s.logger.Error("data export not prepared, error: ", err.Error())
+		return
+	}
+
+	exportID := uuid.NewString()
+	if err = s.dataExportsRepository.SetDataExport(ctx, accountID, exportID, data, s.cfg.DataExportTTL); err != nil {
+		s.logger.Error("data export not stored, error: ", err.Error())
+		return
+	}
+	record.Target = exportID
+
+	token, err := jwt.GenerateToken(exportID, s.cfg.DataExportTokenSecret, s.cfg.DataExportTTL)
+	if err != nil {
+		s.logger.Error("data export token not generated, error: ", err.Error())
+		return
+	}
+
+	email, err := s.accountsRepository.GetAccountEmail(ctx, accountID)
+	if err != nil {
+		s.logger.Error("data export ready event not sent, error: ", err.Error())
+		return
+	}
+	expiresAt := time.Now().In(time.UTC).Add(s.cfg.DataExportTTL)
+	if err = s.accountEvents.DataExportReady(ctx, accountID, email, token, expiresAt); err != nil {
+		s.logEventError("data export ready", err)
+	}
+}
+
+// clearDataExportCooldown clears the cooldown even if the context is canceled,
+// so the failed export can be requested again.
+func (s *accountsService) clearDataExportCooldown(ctx context.Context, accountID string) {
+	if s.cfg.DataExportCooldown <= 0 {
+		return
+	}
+	if err := s.dataExportsRepository.ClearDataExportCooldown(context.WithoutCancel(ctx), accountID); err != nil {
+		s.logger.Error("data export cooldown not cleared, error: ", err.Error())
+	}
+}
+
+// DataExportWorker prepares the requested data exports one by one.
+type DataExportWorker struct {
+	service *accountsService
+	logger  *logrus.Logger
+}
+
+func NewDataExportWorker(service *accountsService, logger *logrus.Logger) *DataExportWorker {
+	return &DataExportWorker{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Run prepares the requested exports until the context is canceled. The export being prepared is canceled
+// with the context, the cooldowns of it and of the exports left in the queue are cleared.
+func (w *DataExportWorker) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			w.dropQueued(ctx)
+			return
+		case request := <-w.service.dataExportRequests:
+			w.service.prepareDataExport(ctx, request)
+		}
+	}
+}
+
+func (w *DataExportWorker) dropQueued(ctx context.Context) {
+	for {
+		select {
+		case request := <-w.service.dataExportRequests:
+			w.logger.Warningf("data export of the account %s not prepared on shutdown", request.accountID)
+			w.service.clearDataExportCooldown(ctx, request.accountID)
+		default:
+			return
+		}
+	}
+}
+
+// DownloadAccountDataExport returns the prepared export, the export is returned only to the session of its account,
+// so the download token alone isn't enough to get the export.
+func (s *accountsService) DownloadAccountDataExport(ctx context.Context,
+	sessionID, machineID, token string) ([]byte, error) {
+	s.logger.Info("Checking session")
+	session, err := s.checkAndUpdateSession(ctx, machineID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Parsing jwt token")
+	exportID, err := jwt.ParseToken(token, s.cfg.DataExportTokenSecret)
+	if err != nil {
+		return nil, models.Error(models.InvalidArgument, err.Error())
+	}
+
+	data, err := s.dataExportsRepository.GetDataExport(ctx, session.AccountID, exportID)
+	if models.Code(err) == models.NotFound {
+		return nil, models.Error(models.NotFound, "data export not found or expired")
+	}
+	return data, err
+}
+
+// buildDataExport collects the personal data of the account into the JSON document.
+// The sessions ids aren't exported, the export mustn't contain the credentials.
+// Only the audit records of the account actions are exported, the records of the administrators actions
+// contain the personal data of the administrators.
+func (s *accountsService) buildDataExport(ctx context.Context, accountID string) ([]byte, error) {
+	email, err := s.accountsRepository.GetAccountEmail(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	account, err := s.accountsRepository.GetAccountByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	export := models.AccountDataExport{
+		ExportedAt:    time.Now().In(time.UTC),
+		Account:       account,
+		Sessions:      []models.SessionInfo{},
+		SignInHistory: []models.SignInAttempt{},
+		AuditLog:      []models.AuditRecord{},
+	}
+
+	sessions, err := s.sessionsRepository.GetSessionsForAccount(ctx, accountID)
+	if err != nil && models.Code(err) != models.NotFound {
+		return nil, err
+	}
+	for _, session := range sessions {
+		export.Sessions = append(export.Sessions, *session)
+	}
+
+	var beforeID int64
+	for {
+		attempts, err := s.signInHistoryRepository.GetSignInHistory(ctx, accountID, beforeID, dataExportPageSize)
+		if err != nil {
+			return nil, err
+		}
+		export.SignInHistory = append(export.SignInHistory, attempts...)
+		if len(attempts) < dataExportPageSize {
+			break
+		}
+		beforeID = attempts[len(attempts)-1].ID
+	}
+
+	beforeID = 0
+	for {
+		records, err := s.auditLogRepository.GetAuditRecords(ctx,
+			models.AuditLogFilter{ActorID: accountID}, beforeID, dataExportPageSize)
+		if err != nil {
+			return nil, err
+		}
+		export.AuditLog = append(export.AuditLog, records...)
+		if len(records) < dataExportPageSize {
+			break
+		}
+		beforeID = records[len(records)-1].ID
+	}
+
+	data, err := json.Marshal(export)
+	if err != nil {
+		return nil, models.Error(models.Internal, err.Error())
+	}
+	return data, nil
+}
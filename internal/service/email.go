@@ -0,0 +1,29 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"golang.org/x/net/idna"
+)
+
+// normalizeEmail trims the email, lowercases its domain and converts the internationalized domain to ASCII.
+// The local part keeps its case, the emails are compared case-insensitively by the repositories.
+func normalizeEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
+	at := strings.LastIndex(email, "@")
+	if at <= 0 || at == len(email)-1 {
+		return "", models.Error(models.InvalidArgument, "invalid email")
+	}
+
+	domain, err := idna.Lookup.ToASCII(strings.TrimSuffix(email[at+1:], "."))
+	if err != nil {
+		return "", models.Error(models.InvalidArgument, "invalid email domain")
+	}
+	return email[:at+1] + strings.ToLower(domain), nil
+}
+
+// getEmailDomain returns the domain of the normalized email.
+func getEmailDomain(email string) string {
+	return email[strings.LastIndex(email, "@")+1:]
+}
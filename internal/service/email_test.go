@@ -0,0 +1,36 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/Falokut/accounts_service/internal/models"
+)
+
+func TestNormalizeEmail(t *testing.T) {
+	cases := []struct {
+		email    string
+		expected string
+	}{
+		{email: "bob@example.com", expected: "bob@example.com"},
+		{email: "  Bob@Example.COM ", expected: "Bob@example.com"},
+		{email: "bob@example.com.", expected: "bob@example.com"},
+		{email: "bob@Bücher.example", expected: "bob@xn--bcher-kva.example"},
+		{email: `"bob@home"@example.com`, expected: `"bob@home"@example.com`},
+	}
+	for _, c := range cases {
+		normalized, err := normalizeEmail(c.email)
+		if err != nil {
+			t.Errorf("%q: unexpected error %v", c.email, err)
+			continue
+		}
+		if normalized != c.expected {
+			t.Errorf("%q: normalized %q, expected %q", c.email, normalized, c.expected)
+		}
+	}
+
+	for _, email := range []string{"", "bob", "@example.com", "bob@", "bob@exa mple.com"} {
+		if _, err := normalizeEmail(email); models.Code(err) != models.InvalidArgument {
+			t.Errorf("%q: expected invalid argument error, got %v", email, err)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+)
+
+// RegistrationMode defines who can register the accounts.
+type RegistrationMode string
+
+const (
+	// OpenRegistrationMode allows anyone to register.
+	OpenRegistrationMode RegistrationMode = "open"
+	// InviteOnlyRegistrationMode allows to register only with the valid invitation code.
+	InviteOnlyRegistrationMode RegistrationMode = "invite_only"
+)
+
+const invitationCodePrefix = "inv_"
+
+func (s *accountsService) CreateInvitation(ctx context.Context, sessionID, machineID string,
+	dto models.CreateInvitationDTO) (code string, invitation models.Invitation, err error) {
+	session, err := s.checkAdminSession(ctx, machineID, sessionID)
+	if err != nil {
+		return
+	}
+
+	record := models.AuditRecord{
+		ActorID: session.AccountID,
+		Action:  models.InvitationCreatedAuditAction,
+	}
+	defer func() { s.auditLog(ctx, record, err) }()
+
+	now := time.Now().In(time.UTC)
+	invitation = models.Invitation{
+		MaxUses:   dto.MaxUses,
+		CreatedBy: session.AccountID,
+		CreatedAt: now,
+		ExpiresAt: dto.ExpiresAt.In(time.UTC),
+	}
+	if invitation.MaxUses == 0 {
+		invitation.MaxUses = 1
+	}
+	if invitation.MaxUses < 0 {
+		err = models.Error(models.InvalidArgument, "maximum uses of the invitation must be positive")
+		return
+	}
+	if !dto.ExpiresAt.IsZero() && !invitation.ExpiresAt.After(now) {
+		err = models.Error(models.InvalidArgument, "invitation expiration time must be in the future")
+		return
+	}
+	if dto.Email != "" {
+		if invitation.Email, err = normalizeEmail(dto.Email); err != nil {
+			return
+		}
+	}
+
+	code, err = generateSecretToken(invitationCodePrefix)
+	if err != nil {
+		err = models.Error(models.Internal, "can't generate invitation code")
+		return
+	}
+
+	s.logger.Info("Creating invitation")
+	invitation.ID, err = s.invitationsRepository.CreateInvitation(ctx, invitation, hashToken(code))
+	if err != nil {
+		return "", models.Invitation{}, err
+	}
+
+	record.Target = invitation.ID
+	return code, invitation, nil
+}
+
+// GetInvitations returns the usable invitations, the expired and used up invitations are no longer listed.
+func (s *accountsService) GetInvitations(ctx context.Context,
+	sessionID, machineID string) (invitations []models.Invitation, err error) {
+	if _, err = s.checkAdminSession(ctx, machineID, sessionID); err != nil {
+		return
+	}
+
+	invitations, err = s.invitationsRepository.GetUsableInvitations(ctx, time.Now().In(time.UTC))
+	return
+}
+
+func (s *accountsService) RevokeInvitation(ctx context.Context, sessionID, machineID, invitationID string) (err error) {
+	session, err := s.checkAdminSession(ctx, machineID, sessionID)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		s.auditLog(ctx, models.AuditRecord{
+			ActorID: session.AccountID,
+			Action:  models.InvitationRevokedAuditAction,
+			Target:  invitationID,
+		}, err)
+	}()
+
+	s.logger.Info("Revoking invitation")
+	err = s.invitationsRepository.RevokeInvitation(ctx, invitationID)
+	return
+}
+
+// checkInvitation checks that the invitation code is usable for the registration of the email.
+// The invitation is used when the account is verified, see createAccount.
+func (s *accountsService) checkInvitation(ctx context.Context, code, email string) (models.Invitation, error) {
+	if code == "" {
+		return models.Invitation{}, models.Error(models.PermissionDenied, "the registration is invite-only, invite code is required")
+	}
+
+	s.logger.Info("Checking invitation")
+	invitation, err := s.invitationsRepository.GetInvitationByCode(ctx, hashToken(code))
+	if models.Code(err) == models.NotFound {
+		return models.Invitation{}, models.Error(models.PermissionDenied, "invalid or expired invite code")
+	}
+	if err != nil {
+		return models.Invitation{}, err
+	}
+
+	if !invitation.IsUsable(time.Now().In(time.UTC)) ||
+		(invitation.Email != "" && !strings.EqualFold(invitation.Email, email)) {
+		return models.Invitation{}, models.Error(models.PermissionDenied, "invalid or expired invite code")
+	}
+	return invitation, nil
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/Falokut/accounts_service/internal/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// invitationsRepositoryStub stores the invitations by the code hash.
+type invitationsRepositoryStub struct {
+	repository.InvitationsRepository
+	invitations map[string]models.Invitation
+}
+
+func (r invitationsRepositoryStub) GetInvitationByCode(_ context.Context, codeHash string) (models.Invitation, error) {
+	invitation, ok := r.invitations[codeHash]
+	if !ok {
+		return models.Invitation{}, models.Error(models.NotFound, "invitation not found")
+	}
+	return invitation, nil
+}
+
+func TestCheckInvitation(t *testing.T) {
+	now := time.Now().In(time.UTC)
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	s := &accountsService{
+		logger: logger,
+		invitationsRepository: invitationsRepositoryStub{invitations: map[string]models.Invitation{
+			hashToken("open"):     {ID: "open", MaxUses: 2, Uses: 1},
+			hashToken("bound"):    {ID: "bound", Email: "bob@example.com", MaxUses: 1},
+			hashToken("used-up"):  {ID: "used-up", MaxUses: 2, Uses: 2},
+			hashToken("expired"):  {ID: "expired", MaxUses: 1, ExpiresAt: now.Add(-time.Minute)},
+			hashToken("expiring"): {ID: "expiring", MaxUses: 1, ExpiresAt: now.Add(time.Hour)},
+		}},
+	}
+
+	cases := []struct {
+		code  string
+		email string
+		// the id of the returned invitation, empty if the code is rejected
+		expectedID string
+	}{
+		{code: "open", email: "alice@example.com", expectedID: "open"},
+		{code: "bound", email: "BOB@example.com", expectedID: "bound"},
+		{code: "bound", email: "alice@example.com"},
+		{code: "used-up", email: "alice@example.com"},
+		{code: "expired", email: "alice@example.com"},
+		{code: "expiring", email: "alice@example.com", expectedID: "expiring"},
+		{code: "unknown", email: "alice@example.com"},
+		{code: "", email: "alice@example.com"},
+	}
+	for _, c := range cases {
+		invitation, err := s.checkInvitation(context.Background(), c.code, c.email)
+		if c.expectedID == "" {
+			if models.Code(err) != models.PermissionDenied {
+				t.Errorf("%q for %q: expected permission denied, got %v", c.code, c.email, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q for %q: unexpected error %v", c.code, c.email, err)
+			continue
+		}
+		if invitation.ID != c.expectedID {
+			t.Errorf("%q for %q: invitation %q, expected %q", c.code, c.email, invitation.ID, c.expectedID)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"net"
+
+	"github.com/Falokut/accounts_service/internal/models"
+)
+
+const (
+	ipv4RangeBits = 24
+	ipv6RangeBits = 64
+)
+
+// checkNewDevice remembers the device of the session and sends the new_device_sign_in event,
+// if the machine or the ip range of the client was never seen for the account.
+// The first sign in to the account isn't reported. The errors aren't critical for the sign in, so they are only logged.
+func (s *accountsService) checkNewDevice(ctx context.Context, email string, session *models.Session) {
+	s.logger.Info("Checking sign in device")
+	recognition, err := s.knownDevicesRepository.RememberDevice(ctx, session.AccountID,
+		session.MachineID, getIPRange(session.ClientIP), session.CreatedAt)
+	if err != nil {
+		s.logger.Warning("sign in device not checked, error: ", err.Error())
+		return
+	}
+
+	if !recognition.HasKnownDevices || (recognition.MachineKnown && recognition.IPRangeKnown) {
+		return
+	}
+
+	s.logger.Info("Sending new device sign in event")
+	err = s.accountEvents.NewDeviceSignIn(ctx, models.NewDeviceSignInDTO{
+		AccountID:  session.AccountID,
+		Email:      email,
+		ClientIP:   session.ClientIP,
+		MachineID:  session.MachineID,
+		UserAgent:  session.UserAgent,
+		DeviceName: session.DeviceName,
+		Location:   session.Location,
+		SignInTime: session.CreatedAt,
+	})
+	s.logEventError("new device sign in", err)
+}
+
+// getIPRange returns the network of the ip address in CIDR notation,
+// /24 for IPv4 and /64 for IPv6 addresses, so the clients with dynamic addresses aren't reported on each sign in.
+func getIPRange(clientIP string) string {
+	ip := net.ParseIP(clientIP)
+	if ip == nil {
+		return clientIP
+	}
+
+	mask := net.CIDRMask(ipv6RangeBits, 8*net.IPv6len)
+	if ipv4 := ip.To4(); ipv4 != nil {
+		ip, mask = ipv4, net.CIDRMask(ipv4RangeBits, 8*net.IPv4len)
+	}
+	return (&net.IPNet{IP: ip.Mask(mask), Mask: mask}).String()
+}
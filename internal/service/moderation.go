@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/google/uuid"
+)
+
+// SuspendAccount suspends the account on the moderation request and terminates its sessions.
+// The suspension is idempotent, so the redelivered request doesn't change the suspension.
+func (s *accountsService) SuspendAccount(ctx context.Context, accountID, reason string) (err error) {
+	if _, err = uuid.Parse(accountID); err != nil {
+		return models.Error(models.InvalidArgument, "invalid account id")
+	}
+
+	record := models.AuditRecord{
+		SubjectAccountID: accountID,
+		Action:           models.AccountSuspendedAuditAction,
+	}
+	defer func() {
+		if models.Code(err) != models.NotFound {
+			s.auditLog(ctx, record, err)
+		}
+	}()
+
+	s.logger.Info("Suspending account")
+	err = s.accountsRepository.SuspendAccount(ctx, accountID, reason, time.Now().In(time.UTC))
+	if err != nil {
+		return
+	}
+
+	// The sessions are terminated before the request is acknowledged, so the failed termination is retried.
+	sessionsIds, err := s.sessionsRepository.GetSessionsIds(ctx, accountID)
+	if err != nil && models.Code(err) != models.NotFound {
+		return
+	}
+	err = s.sessionsRepository.TerminateAllSessions(ctx, accountID)
+	if err != nil && models.Code(err) != models.NotFound {
+		return
+	}
+	s.sessionsTerminated(ctx, accountID, sessionsIds, models.TerminatedTerminationReason)
+	return nil
+}
+
+// MarkEmailUndeliverable marks the email as undeliverable on the bounce notification.
+func (s *accountsService) MarkEmailUndeliverable(ctx context.Context, email string) (err error) {
+	if email == "" {
+		return models.Error(models.InvalidArgument, "email is required")
+	}
+	if email, err = normalizeEmail(email); err != nil {
+		return
+	}
+
+	account, err := s.accountsRepository.GetAccountByEmail(ctx, email)
+	if err != nil {
+		return
+	}
+	if account.EmailUndeliverable {
+		return nil
+	}
+
+	record := models.AuditRecord{
+		SubjectAccountID: account.ID,
+		Action:           models.EmailMarkedUndeliverableAuditAction,
+	}
+	defer func() { s.auditLog(ctx, record, err) }()
+
+	s.logger.Info("Marking email as undeliverable")
+	err = s.accountsRepository.MarkEmailUndeliverable(ctx, email)
+	return
+}
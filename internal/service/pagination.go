@@ -0,0 +1,35 @@
+package service
+
+import (
+	"strconv"
+
+	"github.com/Falokut/accounts_service/internal/models"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// parsePage validates the page size and the page token.
+// The page token is the id of the last item of the previous page, 0 is returned for the first page.
+func parsePage(pageSize int, pageToken string) (size int, beforeID int64, err error) {
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize < 0 || pageSize > maxPageSize {
+		return 0, 0, models.Errorf(models.InvalidArgument, "page size must be positive and not greater than %d", maxPageSize)
+	}
+
+	if pageToken != "" {
+		beforeID, err = strconv.ParseInt(pageToken, 10, 64)
+		if err != nil || beforeID <= 0 {
+			return 0, 0, models.Error(models.InvalidArgument, "invalid page token")
+		}
+	}
+	return pageSize, beforeID, nil
+}
+
+func getPageToken(lastID int64) string {
+	return strconv.FormatInt(lastID, 10)
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type PasswordVerificationConfig struct {
+	// The number of the failed password checks of the account within the window,
+	// after which the checks are rejected, the lockout is disabled if negative
+	MaxFailedAttempts int
+	LockoutWindow     time.Duration
+}
+
+// Reauthenticate checks the password of the session account and marks the session as recently authenticated.
+func (s *accountsService) Reauthenticate(ctx context.Context, sessionID, machineID, password string) (err error) {
+	s.logger.Info("Checking session")
+	session, err := s.checkSession(ctx, machineID, sessionID)
+	if err != nil {
+		return
+	}
+
+	if err = s.verifyPassword(ctx, session, password); err != nil {
+		return
+	}
+
+	ttl := s.getSessionTTL(&session)
+	if ttl <= 0 {
+		return models.Error(models.Unauthenticated, "session expired, please sign in again")
+	}
+
+	s.logger.Info("Updating session authentication time")
+	err = s.sessionsRepository.UpdateAuthenticationTimeForSession(ctx, &session, time.Now().In(time.UTC), ttl)
+	if models.Code(err) == models.NotFound {
+		return models.Error(models.Unauthenticated, "session terminated, please sign in again")
+	}
+	return
+}
+
+// verifyPassword compares the password with the password hash of the session account.
+// The failed checks are recorded in the sign in history and the audit log,
+// the checks are rejected after the maximum number of the failed checks within the lockout window.
+func (s *accountsService) verifyPassword(ctx context.Context, session models.Session, password string) error {
+	if password == "" {
+		return models.Error(models.InvalidArgument, "password is required")
+	}
+
+	if err := s.checkPasswordVerificationLockout(ctx, session.AccountID); err != nil {
+		return err
+	}
+
+	email, err := s.accountsRepository.GetAccountEmail(ctx, session.AccountID)
+	if err != nil {
+		return err
+	}
+	account, err := s.accountsRepository.GetAccountByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("Password and hash comparison")
+	if err = bcrypt.CompareHashAndPassword([]byte(account.Password), []byte(password)); err != nil {
+		err = models.Error(models.InvalidArgument, "invalid password")
+		attempt := models.SignInAttempt{
+			AccountID:     session.AccountID,
+			AttemptedAt:   time.Now().In(time.UTC),
+			ClientIP:      session.ClientIP,
+			MachineID:     session.MachineID,
+			UserAgent:     session.UserAgent,
+			FailureReason: models.PasswordVerificationFailureReason,
+		}
+		s.recordSignInAttempt(ctx, attempt)
+		s.logEventError("sign in failed", s.accountEventsOutbox.SignInFailed(ctx, nil, attempt))
+		s.auditLog(ctx, models.AuditRecord{
+			ActorID:          session.AccountID,
+			SubjectAccountID: session.AccountID,
+			Action:           models.PasswordVerificationFailedAuditAction,
+		}, err)
+		return err
+	}
+	return nil
+}
+
+func (s *accountsService) checkPasswordVerificationLockout(ctx context.Context, accountID string) error {
+	cfg := s.cfg.PasswordVerification
+	if cfg.MaxFailedAttempts < 0 {
+		return nil
+	}
+
+	failed, err := s.signInHistoryRepository.CountFailedAttempts(ctx, accountID,
+		models.PasswordVerificationFailureReason, time.Now().In(time.UTC).Add(-cfg.LockoutWindow))
+	if err != nil {
+		return err
+	}
+	if failed >= cfg.MaxFailedAttempts {
+		return models.Error(models.ResourceExhausted, "too many invalid password attempts, try again later")
+	}
+	return nil
+}
+
+// requireRecentAuthentication checks that the password was entered in the session
+// within the recent authentication window, the sensitive operations call it after the session check.
+func (s *accountsService) requireRecentAuthentication(session models.Session) error {
+	if s.cfg.RecentAuthenticationWindow <= 0 {
+		return nil
+	}
+	if time.Since(session.AuthenticatedAt) > s.cfg.RecentAuthenticationWindow {
+		return models.Error(models.PermissionDenied,
+			"recent authentication required, please reauthenticate with the password")
+	}
+	return nil
+}
@@ -2,10 +2,14 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Falokut/accounts_service/internal/config"
+	"github.com/Falokut/accounts_service/internal/domainpolicy"
 	"github.com/Falokut/accounts_service/internal/events"
+	"github.com/Falokut/accounts_service/internal/geolocation"
 	"github.com/Falokut/accounts_service/internal/models"
 	"github.com/Falokut/accounts_service/internal/repository"
 	accounts_service "github.com/Falokut/accounts_service/pkg/accounts_service/v1/protos"
@@ -18,16 +22,46 @@ import (
 
 type AccountsService interface {
 	CreateAccount(ctx context.Context, dto models.CreateAccountDTO) error
-	DeleteAccount(ctx context.Context, sessionID, machineID string) error
+	DeleteAccount(ctx context.Context, sessionID, machineID, password string) error
+	RequestAccountRestoreToken(ctx context.Context, email, callbackURL string) error
+	RestoreAccount(ctx context.Context, token string) error
 	RequestAccountVerificationToken(ctx context.Context, email, callbackURL string) error
 	VerifyAccount(ctx context.Context, token string) error
 	SignIn(ctx context.Context, dto models.SignInDTO) (sessionID string, err error)
 	GetAccountID(ctx context.Context, sessionID, machineID string) (accountID string, err error)
 	Logout(ctx context.Context, sessionID, machineID string) error
+	Reauthenticate(ctx context.Context, sessionID, machineID, password string) error
 	RequestChangePasswordToken(ctx context.Context, email, callbackURL string) error
 	ChangePassword(ctx context.Context, token, newPassword string) error
 	GetAllSessions(ctx context.Context, sessionID, machineID string) (map[string]*models.SessionInfo, error)
+	GetSignInHistory(ctx context.Context, sessionID, machineID string,
+		pageSize int, pageToken string) (attempts []models.SignInAttempt, nextPageToken string, err error)
 	TerminateSessions(ctx context.Context, sessionID, machineID string, sessionsToTerminateIds []string) error
+	TerminateOtherSessions(ctx context.Context, sessionID, machineID string) error
+
+	CreateAccessToken(ctx context.Context, sessionID, machineID string,
+		dto models.CreateAccessTokenDTO) (token string, info models.AccessToken, err error)
+	GetAccessTokens(ctx context.Context, sessionID, machineID string) ([]models.AccessToken, error)
+	RevokeAccessToken(ctx context.Context, sessionID, machineID, tokenID string) error
+	GetTokenSubject(ctx context.Context, token string) (models.TokenSubject, error)
+
+	CreateServiceAccount(ctx context.Context, sessionID, machineID, name string) (models.ServiceAccount, error)
+	GetServiceAccounts(ctx context.Context, sessionID, machineID string) ([]models.ServiceAccount, error)
+	IssueServiceAccountCredentials(ctx context.Context, sessionID, machineID,
+		serviceAccountID string) (clientID, clientSecret string, err error)
+	RevokeServiceAccountCredentials(ctx context.Context, sessionID, machineID, serviceAccountID, clientID string) error
+	GetServiceAccountToken(ctx context.Context, clientID, clientSecret string) (token string, ttl time.Duration, err error)
+
+	QueryAuditLog(ctx context.Context, sessionID, machineID string, filter models.AuditLogFilter,
+		pageSize int, pageToken string) (records []models.AuditRecord, nextPageToken string, err error)
+
+	CreateInvitation(ctx context.Context, sessionID, machineID string,
+		dto models.CreateInvitationDTO) (code string, invitation models.Invitation, err error)
+	GetInvitations(ctx context.Context, sessionID, machineID string) ([]models.Invitation, error)
+	RevokeInvitation(ctx context.Context, sessionID, machineID, invitationID string) error
+	ExportAccountData(ctx context.Context, sessionID, machineID string) ([]byte, error)
+	RequestAccountDataExport(ctx context.Context, sessionID, machineID string) error
+	DownloadAccountDataExport(ctx context.Context, sessionID, machineID, token string) ([]byte, error)
 }
 
 type AccountsServiceConfig struct {
@@ -40,38 +74,113 @@ type AccountsServiceConfig struct {
 	NonActivatedAccountTTL             time.Duration
 	BcryptCost                         int
 	SessionTTL                         time.Duration
+	RememberMeSessionTTL               time.Duration
+	SessionMaxLifetime                 time.Duration
+	SessionsLimit                      SessionsLimitConfig
+	AccessTokenMaxTTL                  time.Duration
+	MaxAccessTokensPerAccount          int
+	ServiceAccountTokenTTL             time.Duration
+	ServiceAccountTokenSecret          string
+
+	// The time that the deleted account can be restored, the account is deleted immediately if 0
+	AccountDeletionGracePeriod time.Duration
+	RestoreAccountTokenTTL     time.Duration
+	RestoreAccountTokenSecret  string
+
+	// The time after the password is entered in the session, during which the sensitive operations are allowed,
+	// the recent authentication isn't required if 0
+	RecentAuthenticationWindow time.Duration
+	PasswordVerification       PasswordVerificationConfig
+
+	// The lifetime of the prepared data export and its download token
+	DataExportTTL         time.Duration
+	DataExportTokenSecret string
+	// The minimum time between the asynchronous data export requests of the account, no cooldown if not positive
+	DataExportCooldown time.Duration
+
+	RegistrationMode RegistrationMode
 }
 
 type accountsService struct {
 	accounts_service.UnimplementedAccountsServiceV1Server
-	accountsRepository     repository.AccountRepository
-	registrationRepository repository.RegistrationRepository
-	sessionsRepository     repository.SessionsRepository
-	logger                 *logrus.Logger
-	cfg                    AccountsServiceConfig
-	accountEvents          events.AccountsEventsMQ
-	tokenDeliveryMQ        events.TokensDeliveryMQ
+	accountsRepository        repository.AccountRepository
+	registrationRepository    repository.RegistrationRepository
+	sessionsRepository        repository.SessionsRepository
+	accessTokensRepository    repository.AccessTokensRepository
+	serviceAccountsRepository repository.ServiceAccountsRepository
+	knownDevicesRepository    repository.KnownDevicesRepository
+	signInHistoryRepository   repository.SignInHistoryRepository
+	auditLogRepository        repository.AuditLogRepository
+	dataExportsRepository     repository.DataExportsRepository
+	invitationsRepository     repository.InvitationsRepository
+	logger                    *logrus.Logger
+	cfg                       AccountsServiceConfig
+	accountEvents             events.AccountsEventsMQ
+	accountEventsOutbox       events.AccountsEventsOutbox
+	tokenDeliveryMQ           events.TokensDeliveryMQ
+	locator                   geolocation.Locator
+	domainPolicy              domainpolicy.Policy
+	// the requested data exports, see DataExportWorker
+	dataExportRequests chan dataExportRequest
 }
 
 func NewAccountsService(repo repository.AccountRepository,
 	logger *logrus.Logger,
 	registrationRepository repository.RegistrationRepository,
 	sessionsRepository repository.SessionsRepository,
+	accessTokensRepository repository.AccessTokensRepository,
+	serviceAccountsRepository repository.ServiceAccountsRepository,
+	knownDevicesRepository repository.KnownDevicesRepository,
+	signInHistoryRepository repository.SignInHistoryRepository,
+	auditLogRepository repository.AuditLogRepository,
+	dataExportsRepository repository.DataExportsRepository,
+	invitationsRepository repository.InvitationsRepository,
 	accountEvents events.AccountsEventsMQ,
+	accountEventsOutbox events.AccountsEventsOutbox,
 	tokenDeliveryMQ events.TokensDeliveryMQ,
+	locator geolocation.Locator,
+	domainPolicy domainpolicy.Policy,
 	cfg *AccountsServiceConfig) *accountsService {
 	return &accountsService{accountsRepository: repo,
-		logger:                 logger,
-		registrationRepository: registrationRepository,
-		sessionsRepository:     sessionsRepository,
-		cfg:                    *cfg,
-		tokenDeliveryMQ:        tokenDeliveryMQ,
-		accountEvents:          accountEvents,
+		logger:                    logger,
+		registrationRepository:    registrationRepository,
+		sessionsRepository:        sessionsRepository,
+		accessTokensRepository:    accessTokensRepository,
+		serviceAccountsRepository: serviceAccountsRepository,
+		knownDevicesRepository:    knownDevicesRepository,
+		signInHistoryRepository:   signInHistoryRepository,
+		auditLogRepository:        auditLogRepository,
+		dataExportsRepository:     dataExportsRepository,
+		invitationsRepository:     invitationsRepository,
+		cfg:                       *cfg,
+		tokenDeliveryMQ:           tokenDeliveryMQ,
+		accountEvents:             accountEvents,
+		accountEventsOutbox:       accountEventsOutbox,
+		locator:                   locator,
+		domainPolicy:              domainPolicy,
+		dataExportRequests:        make(chan dataExportRequest, dataExportQueueSize),
 	}
 }
 
 func (s *accountsService) CreateAccount(ctx context.Context,
 	dto models.CreateAccountDTO) (err error) {
+	if dto.Email, err = normalizeEmail(dto.Email); err != nil {
+		return err
+	}
+
+	var invitation models.Invitation
+	if s.cfg.RegistrationMode == InviteOnlyRegistrationMode {
+		if invitation, err = s.checkInvitation(ctx, dto.InviteCode, dto.Email); err != nil {
+			return err
+		}
+	}
+	// The email bound to the invitation is approved by the administrator.
+	if invitation.Email == "" {
+		if err = s.domainPolicy.CheckDomain(getEmailDomain(dto.Email)); err != nil {
+			return err
+		}
+	}
+
 	exist, err := s.accountsRepository.IsAccountWithEmailExist(ctx, dto.Email)
 	if err != nil {
 		return err
@@ -98,8 +207,9 @@ func (s *accountsService) CreateAccount(ctx context.Context,
 
 	err = s.registrationRepository.SetAccount(ctx, dto.Email,
 		models.RegisteredAccount{
-			Username: dto.Username,
-			Password: string(passwordHash),
+			Username:     dto.Username,
+			Password:     string(passwordHash),
+			InvitationID: invitation.ID,
 		},
 		s.cfg.NonActivatedAccountTTL)
 
@@ -108,6 +218,10 @@ func (s *accountsService) CreateAccount(ctx context.Context,
 
 func (s *accountsService) RequestAccountVerificationToken(ctx context.Context,
 	email, callbackURL string) (err error) {
+	if email, err = normalizeEmail(email); err != nil {
+		return err
+	}
+
 	exist, err := s.accountsRepository.IsAccountWithEmailExist(ctx, email)
 	if err != nil {
 		return err
@@ -163,17 +277,45 @@ func (s *accountsService) createAccount(ctx context.Context, email string) (err
 		return err
 	}
 
-	err = tx.Commit()
+	// The invitation is used only when the account is created, so the expired registrations don't use it up.
+	if repoAccount.InvitationID != "" {
+		s.logger.Info("Using invitation")
+		err = s.invitationsRepository.UseInvitation(ctx, tx, repoAccount.InvitationID, account.RegistrationDate)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	err = s.addAuditRecord(ctx, tx, models.AuditRecord{
+		ActorID:          accountID,
+		SubjectAccountID: accountID,
+		Action:           models.AccountCreatedAuditAction,
+		Target:           repoAccount.InvitationID,
+	}, nil)
 	if err != nil {
-		return models.Error(models.Internal, err.Error())
+		_ = tx.Rollback()
+		return err
 	}
 
-	err = s.accountEvents.AccountCreated(ctx, models.AccountCreatedDTO{
+	err = s.accountEventsOutbox.AccountCreated(ctx, tx, models.AccountCreatedDTO{
 		ID:               accountID,
 		Email:            account.Email,
 		RegistrationDate: account.RegistrationDate,
 		Username:         repoAccount.Username,
 	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = s.accountEventsOutbox.AccountVerified(ctx, tx, accountID, account.Email)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return models.Error(models.Internal, err.Error())
 	}
@@ -187,30 +329,94 @@ func (s *accountsService) createAccount(ctx context.Context, email string) (err
 }
 
 func (s *accountsService) SignIn(ctx context.Context, dto models.SignInDTO) (sessionID string, err error) {
+	if dto.Email, err = normalizeEmail(dto.Email); err != nil {
+		return
+	}
+
 	s.logger.Info("Getting account by email")
 	account, err := s.accountsRepository.GetAccountByEmail(ctx, dto.Email)
 	if err != nil {
 		return
 	}
 
+	attempt := models.SignInAttempt{
+		AccountID:   account.ID,
+		AttemptedAt: time.Now().In(time.UTC),
+		ClientIP:    dto.ClientIP,
+		MachineID:   dto.MachineID,
+		UserAgent:   dto.UserAgent,
+	}
+
 	s.logger.Info("Password and hash comparison")
 	if err = bcrypt.CompareHashAndPassword([]byte(account.Password), []byte(dto.Password)); err != nil {
+		attempt.FailureReason = models.InvalidPasswordFailureReason
+		s.recordSignInAttempt(ctx, attempt)
+		s.logEventError("sign in failed", s.accountEventsOutbox.SignInFailed(ctx, nil, attempt))
 		err = models.Error(models.InvalidArgument, "invalid login or password")
 		return
 	}
 
+	if account.IsSuspended() {
+		attempt.FailureReason = models.AccountSuspendedFailureReason
+		s.recordSignInAttempt(ctx, attempt)
+		s.logEventError("sign in failed", s.accountEventsOutbox.SignInFailed(ctx, nil, attempt))
+		err = models.Error(models.PermissionDenied, "the account is suspended")
+		return
+	}
+
+	if account.IsPendingDeletion() {
+		attempt.FailureReason = models.AccountPendingDeletionFailureReason
+		s.recordSignInAttempt(ctx, attempt)
+		s.logEventError("sign in failed", s.accountEventsOutbox.SignInFailed(ctx, nil, attempt))
+		err = models.Error(models.FailedPrecondition, fmt.Sprintf("the account is scheduled for deletion on %s, "+
+			"request the account restore token to restore it", account.PurgeAt.Format(time.RFC3339)))
+		return
+	}
+
+	if err = s.enforceSessionsLimit(ctx, account.ID, dto.ClientType); err != nil {
+		if models.Code(err) == models.ResourceExhausted {
+			attempt.FailureReason = models.SessionsLimitExceededFailureReason
+			s.recordSignInAttempt(ctx, attempt)
+			s.logEventError("sign in failed", s.accountEventsOutbox.SignInFailed(ctx, nil, attempt))
+		}
+		return
+	}
+
+	s.logger.Info("Resolving client location")
+	location, err := s.locator.Locate(dto.ClientIP)
+	if err != nil {
+		// The error is not critical, the session will be created without location.
+		s.logger.Warning("client location not resolved, error: ", err.Error())
+	}
+
 	s.logger.Info("Caching session")
 	sessionID = uuid.NewString()
-	err = s.sessionsRepository.SetSession(ctx, &models.Session{
-		SessionID:    sessionID,
-		AccountID:    account.ID,
-		MachineID:    dto.MachineID,
-		ClientIP:     dto.ClientIP,
-		LastActivity: time.Now().In(time.UTC)}, s.cfg.SessionTTL)
+	now := time.Now().In(time.UTC)
+	session := &models.Session{
+		SessionID:       sessionID,
+		AccountID:       account.ID,
+		MachineID:       dto.MachineID,
+		ClientIP:        dto.ClientIP,
+		LastActivity:    now,
+		UserAgent:       dto.UserAgent,
+		DeviceName:      dto.DeviceName,
+		Location:        location,
+		CreatedAt:       now,
+		RememberMe:      dto.RememberMe,
+		AuthenticatedAt: now,
+		ClientType:      dto.ClientType,
+	}
+	err = s.sessionsRepository.SetSession(ctx, session, s.getSessionTTL(session))
 	if err != nil {
 		return "", err
 	}
 
+	attempt.Succeeded = true
+	s.recordSignInAttempt(ctx, attempt)
+	s.logEventError("sign in succeeded", s.accountEventsOutbox.SignInSucceeded(ctx, nil, attempt, sessionID))
+	s.logEventError("session created", s.accountEventsOutbox.SessionCreated(ctx, nil, *session))
+
+	s.checkNewDevice(ctx, account.Email, session)
 	return
 }
 
@@ -234,19 +440,32 @@ func (s *accountsService) Logout(ctx context.Context,
 		return
 	}
 
+	record := models.AuditRecord{
+		ActorID:          session.AccountID,
+		SubjectAccountID: session.AccountID,
+		Action:           models.LogoutAuditAction,
+		Target:           sessionID,
+	}
+	defer func() { s.auditLog(ctx, record, err) }()
+
 	err = s.sessionsRepository.TerminateSessions(ctx, []string{sessionID}, session.AccountID)
+	if err != nil {
+		return
+	}
+
+	s.sessionsTerminated(ctx, session.AccountID, []string{sessionID}, models.LogoutTerminationReason)
 	return
 }
 
 func (s *accountsService) RequestChangePasswordToken(ctx context.Context,
 	email, callbackURL string) (err error) {
-	exist, err := s.accountsRepository.IsAccountWithEmailExist(ctx, email)
-	if err != nil {
+	if email, err = normalizeEmail(email); err != nil {
 		return err
 	}
-	if !exist {
-		err = models.Error(models.NotFound, "account not found")
-		return
+
+	account, err := s.accountsRepository.GetAccountByEmail(ctx, email)
+	if err != nil {
+		return err
 	}
 
 	token, err := jwt.GenerateToken(email, s.cfg.ChangePasswordTokenSecret,
@@ -257,6 +476,11 @@ func (s *accountsService) RequestChangePasswordToken(ctx context.Context,
 	}
 
 	err = s.tokenDeliveryMQ.RequestChangePasswordTokenDelivery(ctx, email, token, callbackURL, s.cfg.ChangePasswordTokenTTL)
+	if err != nil {
+		return
+	}
+
+	s.logEventError("password reset requested", s.accountEvents.PasswordResetRequested(ctx, account.ID, email))
 	return
 }
 
@@ -272,14 +496,17 @@ func (s *accountsService) ChangePassword(ctx context.Context, token, newPassword
 	}
 
 	s.logger.Info("Checking account existing in DB")
-	exist, err := s.accountsRepository.IsAccountWithEmailExist(ctx, email)
+	account, err := s.accountsRepository.GetAccountByEmail(ctx, email)
 	if err != nil {
 		return err
 	}
-	if !exist {
-		err = models.Error(models.NotFound, "account not found")
-		return
+
+	record := models.AuditRecord{
+		ActorID:          account.ID,
+		SubjectAccountID: account.ID,
+		Action:           models.PasswordChangedAuditAction,
 	}
+	defer func() { s.auditLog(ctx, record, err) }()
 
 	s.logger.Info("Generating hash for incoming password")
 	passwordHash, err := bcrypt.GenerateFromPassword([]byte(newPassword),
@@ -291,6 +518,11 @@ func (s *accountsService) ChangePassword(ctx context.Context, token, newPassword
 
 	s.logger.Info("Changing account password")
 	err = s.accountsRepository.ChangePassword(ctx, email, string(passwordHash))
+	if err != nil {
+		return
+	}
+
+	s.logEventError("password changed", s.accountEvents.PasswordChanged(ctx, account.ID, email))
 	return
 }
 
@@ -318,6 +550,14 @@ func (s *accountsService) TerminateSessions(ctx context.Context,
 		return
 	}
 
+	record := models.AuditRecord{
+		ActorID:          session.AccountID,
+		SubjectAccountID: session.AccountID,
+		Action:           models.SessionsTerminatedAuditAction,
+		Target:           strings.Join(sessionsToTerminateIds, ","),
+	}
+	defer func() { s.auditLog(ctx, record, err) }()
+
 	var logout bool
 	for i := range sessionsToTerminateIds {
 		if sessionID == sessionsToTerminateIds[i] {
@@ -334,15 +574,62 @@ func (s *accountsService) TerminateSessions(ctx context.Context,
 		return
 	}
 
+	s.sessionsTerminated(ctx, session.AccountID, sessionsToTerminateIds, models.TerminatedTerminationReason)
 	return
 }
 
-func (s *accountsService) DeleteAccount(ctx context.Context, sessionID, machineID string) (err error) {
+func (s *accountsService) TerminateOtherSessions(ctx context.Context, sessionID, machineID string) (err error) {
+	s.logger.Info("Checking session")
+	session, err := s.checkAndUpdateSession(ctx, machineID, sessionID)
+	if err != nil {
+		return
+	}
+
+	record := models.AuditRecord{
+		ActorID:          session.AccountID,
+		SubjectAccountID: session.AccountID,
+		Action:           models.OtherSessionsTerminatedAuditAction,
+	}
+	defer func() { s.auditLog(ctx, record, err) }()
+
+	s.logger.Info("Terminating other sessions")
+	terminated, err := s.sessionsRepository.TerminateOtherSessions(ctx, session.AccountID, sessionID)
+	if err != nil {
+		return
+	}
+
+	s.logger.Infof("%d sessions terminated", len(terminated))
+	s.sessionsTerminated(ctx, session.AccountID, terminated, models.TerminatedTerminationReason)
+	return
+}
+
+func (s *accountsService) DeleteAccount(ctx context.Context, sessionID, machineID, password string) (err error) {
 	session, err := s.checkSession(ctx, machineID, sessionID)
 	if err != nil {
 		return err
 	}
 
+	record := models.AuditRecord{
+		ActorID:          session.AccountID,
+		SubjectAccountID: session.AccountID,
+		Action:           models.AccountDeletedAuditAction,
+	}
+	defer func() {
+		// The successful deletion is recorded within the transaction.
+		if err != nil {
+			s.auditLog(ctx, record, err)
+		}
+	}()
+
+	if err = s.verifyPassword(ctx, session, password); err != nil {
+		return err
+	}
+
+	if s.cfg.AccountDeletionGracePeriod > 0 {
+		record.Action = models.AccountDeletionScheduledAuditAction
+		return s.scheduleAccountDeletion(ctx, session.AccountID, record)
+	}
+
 	email, err := s.accountsRepository.GetAccountEmail(ctx, session.AccountID)
 	if err != nil {
 		return err
@@ -353,8 +640,15 @@ func (s *accountsService) DeleteAccount(ctx context.Context, sessionID, machineI
 		return err
 	}
 
-	err = s.accountEvents.AccountDeleted(ctx, email, session.AccountID)
+	err = s.addAuditRecord(ctx, tx, record, nil)
 	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = s.accountEventsOutbox.AccountDeleted(ctx, tx, email, session.AccountID)
+	if err != nil {
+		_ = tx.Rollback()
 		return err
 	}
 	err = tx.Commit()
@@ -362,17 +656,35 @@ func (s *accountsService) DeleteAccount(ctx context.Context, sessionID, machineI
 		return models.Error(models.Internal, err.Error())
 	}
 
-	go func(session models.Session) {
-		for i := uint32(0); i < s.cfg.NumRetriesForTerminateSessions; i++ {
-			terr := s.sessionsRepository.TerminateAllSessions(context.Background(), session.AccountID)
-			if terr == nil || models.Code(terr) == models.NotFound {
-				return
-			}
-			time.Sleep(s.cfg.RetrySleepTimeForTerminateSessions)
+	go s.terminateAllSessions(session.AccountID)
+	return nil
+}
+
+// terminateAllSessions terminates all sessions of the account with retries.
+func (s *accountsService) terminateAllSessions(accountID string) {
+	for i := uint32(0); i < s.cfg.NumRetriesForTerminateSessions; i++ {
+		err := s.sessionsRepository.TerminateAllSessions(context.Background(), accountID)
+		if err == nil || models.Code(err) == models.NotFound {
+			return
 		}
-	}(session)
+		time.Sleep(s.cfg.RetrySleepTimeForTerminateSessions)
+	}
+}
 
-	return nil
+// sessionsTerminated sends the session_terminated event for each terminated session.
+func (s *accountsService) sessionsTerminated(ctx context.Context,
+	accountID string, sessionsIds []string, reason models.SessionTerminationReason) {
+	for _, id := range sessionsIds {
+		s.logEventError("session terminated", s.accountEvents.SessionTerminated(ctx, accountID, id, reason))
+	}
+}
+
+// logEventError logs the error of the event, which is sent after the operation is done,
+// so the error doesn't fail the operation.
+func (s *accountsService) logEventError(event string, err error) {
+	if err != nil {
+		s.logger.Warningf("%s event not sent, error: %s", event, err.Error())
+	}
 }
 
 func (s *accountsService) checkSession(ctx context.Context, machineID, sessionID string) (session models.Session, err error) {
@@ -391,13 +703,55 @@ func (s *accountsService) checkSession(ctx context.Context, machineID, sessionID
 		session = models.Session{}
 		return
 	}
+
+	// Sessions created before the absolute lifetime was introduced have no creation time,
+	// their lifetime is counted from the last activity.
+	if session.CreatedAt.IsZero() {
+		session.CreatedAt = session.LastActivity
+	}
+	if session.AuthenticatedAt.IsZero() {
+		session.AuthenticatedAt = session.CreatedAt
+	}
+	if s.cfg.SessionMaxLifetime > 0 && time.Since(session.CreatedAt) >= s.cfg.SessionMaxLifetime {
+		s.logger.Info("Terminating expired session")
+		if err = s.sessionsRepository.TerminateSessions(ctx, []string{sessionID}, session.AccountID); err != nil {
+			s.logger.Warning("expired session not terminated, error: ", err.Error())
+		} else {
+			s.sessionsTerminated(ctx, session.AccountID, []string{sessionID}, models.ExpiredTerminationReason)
+		}
+		err = models.Error(models.Unauthenticated, "session expired, please sign in again")
+		session = models.Session{}
+		return
+	}
 	return
 }
 
+// getSessionTTL returns the idle timeout of the session,
+// which mustn't exceed the remaining absolute lifetime of the session.
+func (s *accountsService) getSessionTTL(session *models.Session) time.Duration {
+	ttl := s.cfg.SessionTTL
+	if session.RememberMe && s.cfg.RememberMeSessionTTL > 0 {
+		ttl = s.cfg.RememberMeSessionTTL
+	}
+
+	if s.cfg.SessionMaxLifetime > 0 {
+		remaining := time.Until(session.CreatedAt.Add(s.cfg.SessionMaxLifetime))
+		if remaining < ttl {
+			ttl = remaining
+		}
+	}
+	return ttl
+}
+
 func (s *accountsService) updateSession(ctx context.Context, session *models.Session, lastActivityTime time.Time) {
+	ttl := s.getSessionTTL(session)
+	if ttl <= 0 {
+		return
+	}
+
 	s.logger.Info("Updating last activity for session")
 	err := s.sessionsRepository.UpdateLastActivityForSession(ctx,
-		session, lastActivityTime, s.cfg.SessionTTL)
+		session, lastActivityTime, ttl)
 	if err != nil && models.Code(err) != models.NotFound {
 		s.logger.Warning("Session last activity not updated, error: ", err.Error())
 	}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/Falokut/accounts_service/pkg/jwt"
+)
+
+const (
+	serviceAccountClientIDPrefix     = "sa_"
+	serviceAccountClientIDBytesSize  = 12
+	serviceAccountClientSecretPrefix = "sas_"
+)
+
+func (s *accountsService) CreateServiceAccount(ctx context.Context,
+	sessionID, machineID, name string) (account models.ServiceAccount, err error) {
+	session, err := s.checkAdminSession(ctx, machineID, sessionID)
+	if err != nil {
+		return
+	}
+
+	record := models.AuditRecord{
+		ActorID: session.AccountID,
+		Action:  models.ServiceAccountCreatedAuditAction,
+	}
+	defer func() { s.auditLog(ctx, record, err) }()
+
+	account = models.ServiceAccount{
+		OwnerID:   session.AccountID,
+		Name:      name,
+		CreatedAt: time.Now().In(time.UTC),
+	}
+
+	s.logger.Info("Creating service account")
+	account.ID, err = s.serviceAccountsRepository.CreateServiceAccount(ctx, account)
+	if err != nil {
+		return models.ServiceAccount{}, err
+	}
+
+	record.Target = account.ID
+	return account, nil
+}
+
+func (s *accountsService) GetServiceAccounts(ctx context.Context,
+	sessionID, machineID string) (accounts []models.ServiceAccount, err error) {
+	session, err := s.checkAdminSession(ctx, machineID, sessionID)
+	if err != nil {
+		return
+	}
+
+	accounts, err = s.serviceAccountsRepository.GetServiceAccounts(ctx, session.AccountID)
+	return
+}
+
+func (s *accountsService) IssueServiceAccountCredentials(ctx context.Context,
+	sessionID, machineID, serviceAccountID string) (clientID, clientSecret string, err error) {
+	session, err := s.checkAdminSession(ctx, machineID, sessionID)
+	if err != nil {
+		return
+	}
+
+	record := models.AuditRecord{
+		ActorID: session.AccountID,
+		Action:  models.ServiceAccountCredentialsIssuedAuditAction,
+		Target:  serviceAccountID,
+	}
+	defer func() { s.auditLog(ctx, record, err) }()
+
+	if err = s.requireRecentAuthentication(session); err != nil {
+		return
+	}
+
+	if err = s.checkServiceAccountOwner(ctx, session.AccountID, serviceAccountID); err != nil {
+		return
+	}
+
+	clientID, err = generateClientID()
+	if err != nil {
+		err = models.Error(models.Internal, "can't generate client id")
+		return
+	}
+	clientSecret, err = generateSecretToken(serviceAccountClientSecretPrefix)
+	if err != nil {
+		err = models.Error(models.Internal, "can't generate client secret")
+		return
+	}
+
+	s.logger.Info("Saving service account credentials")
+	err = s.serviceAccountsRepository.CreateCredentials(ctx, models.ServiceAccountCredentials{
+		ClientID:         clientID,
+		ServiceAccountID: serviceAccountID,
+		SecretHash:       hashToken(clientSecret),
+		CreatedAt:        time.Now().In(time.UTC),
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	record.Target = serviceAccountID + "/" + clientID
+	return clientID, clientSecret, nil
+}
+
+func (s *accountsService) RevokeServiceAccountCredentials(ctx context.Context,
+	sessionID, machineID, serviceAccountID, clientID string) (err error) {
+	session, err := s.checkAdminSession(ctx, machineID, sessionID)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		s.auditLog(ctx, models.AuditRecord{
+			ActorID: session.AccountID,
+			Action:  models.ServiceAccountCredentialsRevokedAuditAction,
+			Target:  serviceAccountID + "/" + clientID,
+		}, err)
+	}()
+
+	if err = s.checkServiceAccountOwner(ctx, session.AccountID, serviceAccountID); err != nil {
+		return
+	}
+
+	s.logger.Info("Revoking service account credentials")
+	err = s.serviceAccountsRepository.DeleteCredentials(ctx, serviceAccountID, clientID)
+	return
+}
+
+func (s *accountsService) GetServiceAccountToken(ctx context.Context,
+	clientID, clientSecret string) (token string, ttl time.Duration, err error) {
+	s.logger.Info("Getting service account credentials")
+	credentials, err := s.serviceAccountsRepository.GetCredentials(ctx, clientID)
+	if models.Code(err) == models.NotFound {
+		err = models.Error(models.Unauthenticated, "invalid client id or client secret")
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	if subtle.ConstantTimeCompare([]byte(credentials.SecretHash), []byte(hashToken(clientSecret))) != 1 {
+		err = models.Error(models.Unauthenticated, "invalid client id or client secret")
+		return
+	}
+
+	token, err = jwt.GenerateToken(credentials.ServiceAccountID, s.cfg.ServiceAccountTokenSecret, s.cfg.ServiceAccountTokenTTL)
+	if err != nil {
+		err = models.Error(models.Internal, err.Error())
+		return
+	}
+
+	return token, s.cfg.ServiceAccountTokenTTL, nil
+}
+
+func (s *accountsService) getServiceAccountTokenSubject(token string) (subject models.TokenSubject, err error) {
+	s.logger.Info("Parsing service account token")
+	serviceAccountID, err := jwt.ParseToken(token, s.cfg.ServiceAccountTokenSecret)
+	if err != nil {
+		err = models.Error(models.Unauthenticated, "invalid or expired access token")
+		return
+	}
+
+	return models.TokenSubject{
+		AccountID:   serviceAccountID,
+		AccountType: models.ServiceAccountType,
+	}, nil
+}
+
+func (s *accountsService) checkServiceAccountOwner(ctx context.Context, ownerID, serviceAccountID string) error {
+	s.logger.Info("Checking service account owner")
+	account, err := s.serviceAccountsRepository.GetServiceAccount(ctx, serviceAccountID)
+	if err != nil {
+		return err
+	}
+
+	// The service accounts of other administrators are hidden.
+	if account.OwnerID != ownerID {
+		return models.Error(models.NotFound, "service account not found")
+	}
+	return nil
+}
+
+func (s *accountsService) checkAdminSession(ctx context.Context, machineID, sessionID string) (session models.Session, err error) {
+	session, err = s.checkAndUpdateSession(ctx, machineID, sessionID)
+	if err != nil {
+		return
+	}
+
+	s.logger.Info("Checking account permissions")
+	isAdmin, err := s.accountsRepository.IsAccountAdmin(ctx, session.AccountID)
+	if err != nil {
+		return models.Session{}, err
+	}
+	if !isAdmin {
+		return models.Session{}, models.Error(models.PermissionDenied, "the operation is allowed only for administrators")
+	}
+
+	return session, nil
+}
+
+func generateClientID() (string, error) {
+	b := make([]byte, serviceAccountClientIDBytesSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return serviceAccountClientIDPrefix + hex.EncodeToString(b), nil
+}
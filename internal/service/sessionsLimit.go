@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Falokut/accounts_service/internal/models"
+)
+
+// SessionsLimitAction is the action performed when a new session exceeds the sessions limit.
+type SessionsLimitAction string
+
+const (
+	// RejectSessionsLimitAction rejects the sign in.
+	RejectSessionsLimitAction SessionsLimitAction = "reject"
+	// EvictSessionsLimitAction terminates the least recently active sessions of the account.
+	EvictSessionsLimitAction SessionsLimitAction = "evict"
+)
+
+type SessionsLimitConfig struct {
+	// The maximum number of simultaneous sessions per account, unlimited if 0
+	MaxPerAccount int
+	// The maximum number of simultaneous sessions per account for the client type
+	MaxPerClientType map[string]int
+	Action           SessionsLimitAction
+}
+
+type sessionInfo struct {
+	id string
+	*models.SessionInfo
+}
+
+// enforceSessionsLimit checks that the account can have one more session with the client type
+// and either rejects the sign in or evicts the least recently active sessions.
+// The limit is best-effort: the sessions are counted before the new session is stored,
+// so the concurrent sign ins of the account may exceed it by the number of the concurrent requests.
+func (s *accountsService) enforceSessionsLimit(ctx context.Context, accountID, clientType string) error {
+	limits := s.cfg.SessionsLimit
+	maxForClientType := limits.MaxPerClientType[clientType]
+	if limits.MaxPerAccount <= 0 && maxForClientType <= 0 {
+		return nil
+	}
+
+	s.logger.Info("Checking account sessions limit")
+	sessions, err := s.sessionsRepository.GetSessionsForAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	// The least recently active sessions go first.
+	all := make([]sessionInfo, 0, len(sessions))
+	for id, info := range sessions {
+		all = append(all, sessionInfo{id: id, SessionInfo: info})
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].LastActivity.Before(all[j].LastActivity)
+	})
+
+	var toEvict []sessionInfo
+	if maxForClientType > 0 {
+		var sameType []sessionInfo
+		for _, session := range all {
+			if session.ClientType == clientType {
+				sameType = append(sameType, session)
+			}
+		}
+		if excess := len(sameType) - maxForClientType + 1; excess > 0 {
+			if limits.Action != EvictSessionsLimitAction {
+				return models.Errorf(models.ResourceExhausted,
+					"the maximum number of %q sessions %d has been reached, terminate unused sessions", clientType, maxForClientType)
+			}
+			toEvict = append(toEvict, sameType[:excess]...)
+		}
+	}
+
+	if limits.MaxPerAccount > 0 {
+		if excess := len(all) - len(toEvict) - limits.MaxPerAccount + 1; excess > 0 {
+			if limits.Action != EvictSessionsLimitAction {
+				return models.Errorf(models.ResourceExhausted,
+					"the maximum number of sessions %d has been reached, terminate unused sessions", limits.MaxPerAccount)
+			}
+			toEvict = appendNotEvicted(toEvict, all, excess)
+		}
+	}
+
+	if len(toEvict) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(toEvict))
+	for i := range toEvict {
+		ids[i] = toEvict[i].id
+	}
+
+	s.logger.Info("Evicting least recently active sessions")
+	if err = s.sessionsRepository.TerminateSessions(ctx, ids, accountID); err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		// The session is already terminated, so an undelivered event doesn't fail the sign in.
+		s.logEventError("session evicted", s.accountEvents.SessionEvicted(ctx, accountID, id))
+	}
+	return nil
+}
+
+// appendNotEvicted appends n sessions from the sorted sessions which aren't evicted yet.
+func appendNotEvicted(evicted, sessions []sessionInfo, n int) []sessionInfo {
+	isEvicted := make(map[string]struct{}, len(evicted))
+	for _, session := range evicted {
+		isEvicted[session.id] = struct{}{}
+	}
+
+	for i := 0; i < len(sessions) && n > 0; i++ {
+		if _, ok := isEvicted[sessions[i].id]; ok {
+			continue
+		}
+		evicted = append(evicted, sessions[i])
+		n--
+	}
+	return evicted
+}
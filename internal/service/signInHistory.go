@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Falokut/accounts_service/internal/models"
+	"github.com/Falokut/accounts_service/internal/repository"
+	"github.com/sirupsen/logrus"
+)
+
+func (s *accountsService) GetSignInHistory(ctx context.Context, sessionID, machineID string,
+	pageSize int, pageToken string) (attempts []models.SignInAttempt, nextPageToken string, err error) {
+	pageSize, beforeID, err := parsePage(pageSize, pageToken)
+	if err != nil {
+		return
+	}
+
+	s.logger.Info("Checking session")
+	session, err := s.checkAndUpdateSession(ctx, machineID, sessionID)
+	if err != nil {
+		return
+	}
+
+	attempts, err = s.signInHistoryRepository.GetSignInHistory(ctx, session.AccountID, beforeID, pageSize)
+	if err != nil {
+		return
+	}
+
+	if len(attempts) == pageSize {
+		nextPageToken = getPageToken(attempts[len(attempts)-1].ID)
+	}
+	return
+}
+
+// recordSignInAttempt stores the sign in attempt, the error isn't critical for the sign in, so it's only logged.
+func (s *accountsService) recordSignInAttempt(ctx context.Context, attempt models.SignInAttempt) {
+	s.logger.Info("Recording sign in attempt")
+	if err := s.signInHistoryRepository.AddSignInAttempt(ctx, attempt); err != nil {
+		s.logger.Warning("sign in attempt not recorded, error: ", err.Error())
+	}
+}
+
+// SignInHistoryPruner periodically deletes the sign in attempts older than the retention period.
+type SignInHistoryPruner struct {
+	repo      repository.SignInHistoryRepository
+	logger    *logrus.Logger
+	retention time.Duration
+	interval  time.Duration
+}
+
+func NewSignInHistoryPruner(repo repository.SignInHistoryRepository, logger *logrus.Logger,
+	retention, interval time.Duration) *SignInHistoryPruner {
+	return &SignInHistoryPruner{
+		repo:      repo,
+		logger:    logger,
+		retention: retention,
+		interval:  interval,
+	}
+}
+
+// Run prunes the sign in history until the context is canceled.
+// The history is stored forever, if the retention period is not positive.
+func (p *SignInHistoryPruner) Run(ctx context.Context) {
+	if p.retention <= 0 || p.interval <= 0 {
+		p.logger.Info("Sign in history pruning disabled")
+		return
+	}
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		p.prune(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *SignInHistoryPruner) prune(ctx context.Context) {
+	p.logger.Info("Pruning sign in history")
+	deleted, err := p.repo.DeleteSignInHistoryBefore(ctx, time.Now().In(time.UTC).Add(-p.retention))
+	if err != nil {
+		p.logger.Warning("sign in history not pruned, error: ", err.Error())
+		return
+	}
+	p.logger.Infof("%d sign in attempts pruned", deleted)
+}
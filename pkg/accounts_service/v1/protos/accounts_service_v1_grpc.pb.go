@@ -8,6 +8,7 @@ package protos
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -24,7 +25,7 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AccountsServiceV1Client interface {
 	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	DeleteAccount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RequestAccountVerificationToken(ctx context.Context, in *VerificationTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	VerifyAccount(ctx context.Context, in *VerifyAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SignIn(ctx context.Context, in *SignInRequest, opts ...grpc.CallOption) (*AccessResponse, error)
@@ -34,6 +35,27 @@ type AccountsServiceV1Client interface {
 	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetAllSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AllSessionsResponse, error)
 	TerminateSessions(ctx context.Context, in *TerminateSessionsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateAccessToken(ctx context.Context, in *CreateAccessTokenRequest, opts ...grpc.CallOption) (*CreateAccessTokenResponse, error)
+	GetAccessTokens(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AccessTokensResponse, error)
+	RevokeAccessToken(ctx context.Context, in *RevokeAccessTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*ServiceAccountInfo, error)
+	GetServiceAccounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServiceAccountsResponse, error)
+	IssueServiceAccountCredentials(ctx context.Context, in *IssueServiceAccountCredentialsRequest, opts ...grpc.CallOption) (*ServiceAccountCredentialsResponse, error)
+	RevokeServiceAccountCredentials(ctx context.Context, in *RevokeServiceAccountCredentialsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetServiceAccountToken(ctx context.Context, in *ServiceAccountTokenRequest, opts ...grpc.CallOption) (*ServiceAccountTokenResponse, error)
+	TerminateOtherSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetSignInHistory(ctx context.Context, in *SignInHistoryRequest, opts ...grpc.CallOption) (*SignInHistoryResponse, error)
+	QueryAuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error)
+	RequestAccountRestoreToken(ctx context.Context, in *AccountRestoreTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RestoreAccount(ctx context.Context, in *RestoreAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Reauthenticate(ctx context.Context, in *ReauthenticateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ExportAccountData(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	RequestAccountDataExport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DownloadAccountDataExport(ctx context.Context, in *DownloadAccountDataExportRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*CreateInvitationResponse, error)
+	GetInvitations(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*InvitationsResponse, error)
+	RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetChallenge(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ChallengeResponse, error)
 }
 
 type accountsServiceV1Client struct {
@@ -53,7 +75,7 @@ func (c *accountsServiceV1Client) CreateAccount(ctx context.Context, in *CreateA
 	return out, nil
 }
 
-func (c *accountsServiceV1Client) DeleteAccount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *accountsServiceV1Client) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/DeleteAccount", in, out, opts...)
 	if err != nil {
@@ -143,12 +165,201 @@ func (c *accountsServiceV1Client) TerminateSessions(ctx context.Context, in *Ter
 	return out, nil
 }
 
+func (c *accountsServiceV1Client) CreateAccessToken(ctx context.Context, in *CreateAccessTokenRequest, opts ...grpc.CallOption) (*CreateAccessTokenResponse, error) {
+	out := new(CreateAccessTokenResponse)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/CreateAccessToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceV1Client) GetAccessTokens(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AccessTokensResponse, error) {
+	out := new(AccessTokensResponse)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/GetAccessTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceV1Client) RevokeAccessToken(ctx context.Context, in *RevokeAccessTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/RevokeAccessToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceV1Client) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*ServiceAccountInfo, error) {
+	out := new(ServiceAccountInfo)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/CreateServiceAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceV1Client) GetServiceAccounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServiceAccountsResponse, error) {
+	out := new(ServiceAccountsResponse)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/GetServiceAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceV1Client) IssueServiceAccountCredentials(ctx context.Context, in *IssueServiceAccountCredentialsRequest, opts ...grpc.CallOption) (*ServiceAccountCredentialsResponse, error) {
+	out := new(ServiceAccountCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/IssueServiceAccountCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceV1Client) RevokeServiceAccountCredentials(ctx context.Context, in *RevokeServiceAccountCredentialsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/RevokeServiceAccountCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceV1Client) GetServiceAccountToken(ctx context.Context, in *ServiceAccountTokenRequest, opts ...grpc.CallOption) (*ServiceAccountTokenResponse, error) {
+	out := new(ServiceAccountTokenResponse)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/GetServiceAccountToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceV1Client) TerminateOtherSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/TerminateOtherSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceV1Client) GetSignInHistory(ctx context.Context, in *SignInHistoryRequest, opts ...grpc.CallOption) (*SignInHistoryResponse, error) {
+	out := new(SignInHistoryResponse)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/GetSignInHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceV1Client) QueryAuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error) {
+	out := new(AuditLogResponse)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/QueryAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceV1Client) RequestAccountRestoreToken(ctx context.Context, in *AccountRestoreTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/RequestAccountRestoreToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceV1Client) RestoreAccount(ctx context.Context, in *RestoreAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/RestoreAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceV1Client) Reauthenticate(ctx context.Context, in *ReauthenticateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/Reauthenticate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceV1Client) ExportAccountData(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/ExportAccountData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceV1Client) RequestAccountDataExport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/RequestAccountDataExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceV1Client) DownloadAccountDataExport(ctx context.Context, in *DownloadAccountDataExportRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/DownloadAccountDataExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceV1Client) CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*CreateInvitationResponse, error) {
+	out := new(CreateInvitationResponse)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/CreateInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceV1Client) GetInvitations(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*InvitationsResponse, error) {
+	out := new(InvitationsResponse)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/GetInvitations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceV1Client) RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/RevokeInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsServiceV1Client) GetChallenge(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ChallengeResponse, error) {
+	out := new(ChallengeResponse)
+	err := c.cc.Invoke(ctx, "/accounts_service.accountsServiceV1/GetChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountsServiceV1Server is the server API for AccountsServiceV1 service.
 // All implementations must embed UnimplementedAccountsServiceV1Server
 // for forward compatibility
 type AccountsServiceV1Server interface {
 	CreateAccount(context.Context, *CreateAccountRequest) (*emptypb.Empty, error)
-	DeleteAccount(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*emptypb.Empty, error)
 	RequestAccountVerificationToken(context.Context, *VerificationTokenRequest) (*emptypb.Empty, error)
 	VerifyAccount(context.Context, *VerifyAccountRequest) (*emptypb.Empty, error)
 	SignIn(context.Context, *SignInRequest) (*AccessResponse, error)
@@ -158,6 +369,27 @@ type AccountsServiceV1Server interface {
 	ChangePassword(context.Context, *ChangePasswordRequest) (*emptypb.Empty, error)
 	GetAllSessions(context.Context, *emptypb.Empty) (*AllSessionsResponse, error)
 	TerminateSessions(context.Context, *TerminateSessionsRequest) (*emptypb.Empty, error)
+	CreateAccessToken(context.Context, *CreateAccessTokenRequest) (*CreateAccessTokenResponse, error)
+	GetAccessTokens(context.Context, *emptypb.Empty) (*AccessTokensResponse, error)
+	RevokeAccessToken(context.Context, *RevokeAccessTokenRequest) (*emptypb.Empty, error)
+	CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*ServiceAccountInfo, error)
+	GetServiceAccounts(context.Context, *emptypb.Empty) (*ServiceAccountsResponse, error)
+	IssueServiceAccountCredentials(context.Context, *IssueServiceAccountCredentialsRequest) (*ServiceAccountCredentialsResponse, error)
+	RevokeServiceAccountCredentials(context.Context, *RevokeServiceAccountCredentialsRequest) (*emptypb.Empty, error)
+	GetServiceAccountToken(context.Context, *ServiceAccountTokenRequest) (*ServiceAccountTokenResponse, error)
+	TerminateOtherSessions(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	GetSignInHistory(context.Context, *SignInHistoryRequest) (*SignInHistoryResponse, error)
+	QueryAuditLog(context.Context, *AuditLogRequest) (*AuditLogResponse, error)
+	RequestAccountRestoreToken(context.Context, *AccountRestoreTokenRequest) (*emptypb.Empty, error)
+	RestoreAccount(context.Context, *RestoreAccountRequest) (*emptypb.Empty, error)
+	Reauthenticate(context.Context, *ReauthenticateRequest) (*emptypb.Empty, error)
+	ExportAccountData(context.Context, *emptypb.Empty) (*httpbody.HttpBody, error)
+	RequestAccountDataExport(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	DownloadAccountDataExport(context.Context, *DownloadAccountDataExportRequest) (*httpbody.HttpBody, error)
+	CreateInvitation(context.Context, *CreateInvitationRequest) (*CreateInvitationResponse, error)
+	GetInvitations(context.Context, *emptypb.Empty) (*InvitationsResponse, error)
+	RevokeInvitation(context.Context, *RevokeInvitationRequest) (*emptypb.Empty, error)
+	GetChallenge(context.Context, *emptypb.Empty) (*ChallengeResponse, error)
 	mustEmbedUnimplementedAccountsServiceV1Server()
 }
 
@@ -168,7 +400,7 @@ type UnimplementedAccountsServiceV1Server struct {
 func (UnimplementedAccountsServiceV1Server) CreateAccount(context.Context, *CreateAccountRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
 }
-func (UnimplementedAccountsServiceV1Server) DeleteAccount(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+func (UnimplementedAccountsServiceV1Server) DeleteAccount(context.Context, *DeleteAccountRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
 }
 func (UnimplementedAccountsServiceV1Server) RequestAccountVerificationToken(context.Context, *VerificationTokenRequest) (*emptypb.Empty, error) {
@@ -198,6 +430,69 @@ func (UnimplementedAccountsServiceV1Server) GetAllSessions(context.Context, *emp
 func (UnimplementedAccountsServiceV1Server) TerminateSessions(context.Context, *TerminateSessionsRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TerminateSessions not implemented")
 }
+func (UnimplementedAccountsServiceV1Server) CreateAccessToken(context.Context, *CreateAccessTokenRequest) (*CreateAccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessToken not implemented")
+}
+func (UnimplementedAccountsServiceV1Server) GetAccessTokens(context.Context, *emptypb.Empty) (*AccessTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccessTokens not implemented")
+}
+func (UnimplementedAccountsServiceV1Server) RevokeAccessToken(context.Context, *RevokeAccessTokenRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccessToken not implemented")
+}
+func (UnimplementedAccountsServiceV1Server) CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*ServiceAccountInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedAccountsServiceV1Server) GetServiceAccounts(context.Context, *emptypb.Empty) (*ServiceAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceAccounts not implemented")
+}
+func (UnimplementedAccountsServiceV1Server) IssueServiceAccountCredentials(context.Context, *IssueServiceAccountCredentialsRequest) (*ServiceAccountCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueServiceAccountCredentials not implemented")
+}
+func (UnimplementedAccountsServiceV1Server) RevokeServiceAccountCredentials(context.Context, *RevokeServiceAccountCredentialsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeServiceAccountCredentials not implemented")
+}
+func (UnimplementedAccountsServiceV1Server) GetServiceAccountToken(context.Context, *ServiceAccountTokenRequest) (*ServiceAccountTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceAccountToken not implemented")
+}
+func (UnimplementedAccountsServiceV1Server) TerminateOtherSessions(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateOtherSessions not implemented")
+}
+func (UnimplementedAccountsServiceV1Server) GetSignInHistory(context.Context, *SignInHistoryRequest) (*SignInHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSignInHistory not implemented")
+}
+func (UnimplementedAccountsServiceV1Server) QueryAuditLog(context.Context, *AuditLogRequest) (*AuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
+func (UnimplementedAccountsServiceV1Server) RequestAccountRestoreToken(context.Context, *AccountRestoreTokenRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAccountRestoreToken not implemented")
+}
+func (UnimplementedAccountsServiceV1Server) RestoreAccount(context.Context, *RestoreAccountRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAccount not implemented")
+}
+func (UnimplementedAccountsServiceV1Server) Reauthenticate(context.Context, *ReauthenticateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reauthenticate not implemented")
+}
+func (UnimplementedAccountsServiceV1Server) ExportAccountData(context.Context, *emptypb.Empty) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAccountData not implemented")
+}
+func (UnimplementedAccountsServiceV1Server) RequestAccountDataExport(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAccountDataExport not implemented")
+}
+func (UnimplementedAccountsServiceV1Server) DownloadAccountDataExport(context.Context, *DownloadAccountDataExportRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadAccountDataExport not implemented")
+}
+func (UnimplementedAccountsServiceV1Server) CreateInvitation(context.Context, *CreateInvitationRequest) (*CreateInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvitation not implemented")
+}
+func (UnimplementedAccountsServiceV1Server) GetInvitations(context.Context, *emptypb.Empty) (*InvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvitations not implemented")
+}
+func (UnimplementedAccountsServiceV1Server) RevokeInvitation(context.Context, *RevokeInvitationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvitation not implemented")
+}
+func (UnimplementedAccountsServiceV1Server) GetChallenge(context.Context, *emptypb.Empty) (*ChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChallenge not implemented")
+}
 func (UnimplementedAccountsServiceV1Server) mustEmbedUnimplementedAccountsServiceV1Server() {}
 
 // UnsafeAccountsServiceV1Server may be embedded to opt out of forward compatibility for this service.
@@ -230,7 +525,7 @@ func _AccountsServiceV1_CreateAccount_Handler(srv interface{}, ctx context.Conte
 }
 
 func _AccountsServiceV1_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(DeleteAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -242,7 +537,7 @@ func _AccountsServiceV1_DeleteAccount_Handler(srv interface{}, ctx context.Conte
 		FullMethod: "/accounts_service.accountsServiceV1/DeleteAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountsServiceV1Server).DeleteAccount(ctx, req.(*emptypb.Empty))
+		return srv.(AccountsServiceV1Server).DeleteAccount(ctx, req.(*DeleteAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -409,6 +704,384 @@ func _AccountsServiceV1_TerminateSessions_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountsServiceV1_CreateAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).CreateAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/CreateAccessToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).CreateAccessToken(ctx, req.(*CreateAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsServiceV1_GetAccessTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).GetAccessTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/GetAccessTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).GetAccessTokens(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsServiceV1_RevokeAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).RevokeAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/RevokeAccessToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).RevokeAccessToken(ctx, req.(*RevokeAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsServiceV1_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).CreateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/CreateServiceAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).CreateServiceAccount(ctx, req.(*CreateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsServiceV1_GetServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).GetServiceAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/GetServiceAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).GetServiceAccounts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsServiceV1_IssueServiceAccountCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueServiceAccountCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).IssueServiceAccountCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/IssueServiceAccountCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).IssueServiceAccountCredentials(ctx, req.(*IssueServiceAccountCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsServiceV1_RevokeServiceAccountCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeServiceAccountCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).RevokeServiceAccountCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/RevokeServiceAccountCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).RevokeServiceAccountCredentials(ctx, req.(*RevokeServiceAccountCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsServiceV1_GetServiceAccountToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceAccountTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).GetServiceAccountToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/GetServiceAccountToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).GetServiceAccountToken(ctx, req.(*ServiceAccountTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsServiceV1_TerminateOtherSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).TerminateOtherSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/TerminateOtherSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).TerminateOtherSessions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsServiceV1_GetSignInHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignInHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).GetSignInHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/GetSignInHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).GetSignInHistory(ctx, req.(*SignInHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsServiceV1_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/QueryAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).QueryAuditLog(ctx, req.(*AuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsServiceV1_RequestAccountRestoreToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRestoreTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).RequestAccountRestoreToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/RequestAccountRestoreToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).RequestAccountRestoreToken(ctx, req.(*AccountRestoreTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsServiceV1_RestoreAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).RestoreAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/RestoreAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).RestoreAccount(ctx, req.(*RestoreAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsServiceV1_Reauthenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReauthenticateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).Reauthenticate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/Reauthenticate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).Reauthenticate(ctx, req.(*ReauthenticateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsServiceV1_ExportAccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).ExportAccountData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/ExportAccountData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).ExportAccountData(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsServiceV1_RequestAccountDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).RequestAccountDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/RequestAccountDataExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).RequestAccountDataExport(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsServiceV1_DownloadAccountDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadAccountDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).DownloadAccountDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/DownloadAccountDataExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).DownloadAccountDataExport(ctx, req.(*DownloadAccountDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsServiceV1_CreateInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).CreateInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/CreateInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).CreateInvitation(ctx, req.(*CreateInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsServiceV1_GetInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).GetInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/GetInvitations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).GetInvitations(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsServiceV1_RevokeInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).RevokeInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/RevokeInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).RevokeInvitation(ctx, req.(*RevokeInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountsServiceV1_GetChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServiceV1Server).GetChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounts_service.accountsServiceV1/GetChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServiceV1Server).GetChallenge(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AccountsServiceV1_ServiceDesc is the grpc.ServiceDesc for AccountsServiceV1 service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -460,6 +1133,90 @@ var AccountsServiceV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TerminateSessions",
 			Handler:    _AccountsServiceV1_TerminateSessions_Handler,
 		},
+		{
+			MethodName: "CreateAccessToken",
+			Handler:    _AccountsServiceV1_CreateAccessToken_Handler,
+		},
+		{
+			MethodName: "GetAccessTokens",
+			Handler:    _AccountsServiceV1_GetAccessTokens_Handler,
+		},
+		{
+			MethodName: "RevokeAccessToken",
+			Handler:    _AccountsServiceV1_RevokeAccessToken_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _AccountsServiceV1_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "GetServiceAccounts",
+			Handler:    _AccountsServiceV1_GetServiceAccounts_Handler,
+		},
+		{
+			MethodName: "IssueServiceAccountCredentials",
+			Handler:    _AccountsServiceV1_IssueServiceAccountCredentials_Handler,
+		},
+		{
+			MethodName: "RevokeServiceAccountCredentials",
+			Handler:    _AccountsServiceV1_RevokeServiceAccountCredentials_Handler,
+		},
+		{
+			MethodName: "GetServiceAccountToken",
+			Handler:    _AccountsServiceV1_GetServiceAccountToken_Handler,
+		},
+		{
+			MethodName: "TerminateOtherSessions",
+			Handler:    _AccountsServiceV1_TerminateOtherSessions_Handler,
+		},
+		{
+			MethodName: "GetSignInHistory",
+			Handler:    _AccountsServiceV1_GetSignInHistory_Handler,
+		},
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _AccountsServiceV1_QueryAuditLog_Handler,
+		},
+		{
+			MethodName: "RequestAccountRestoreToken",
+			Handler:    _AccountsServiceV1_RequestAccountRestoreToken_Handler,
+		},
+		{
+			MethodName: "RestoreAccount",
+			Handler:    _AccountsServiceV1_RestoreAccount_Handler,
+		},
+		{
+			MethodName: "Reauthenticate",
+			Handler:    _AccountsServiceV1_Reauthenticate_Handler,
+		},
+		{
+			MethodName: "ExportAccountData",
+			Handler:    _AccountsServiceV1_ExportAccountData_Handler,
+		},
+		{
+			MethodName: "RequestAccountDataExport",
+			Handler:    _AccountsServiceV1_RequestAccountDataExport_Handler,
+		},
+		{
+			MethodName: "DownloadAccountDataExport",
+			Handler:    _AccountsServiceV1_DownloadAccountDataExport_Handler,
+		},
+		{
+			MethodName: "CreateInvitation",
+			Handler:    _AccountsServiceV1_CreateInvitation_Handler,
+		},
+		{
+			MethodName: "GetInvitations",
+			Handler:    _AccountsServiceV1_GetInvitations_Handler,
+		},
+		{
+			MethodName: "RevokeInvitation",
+			Handler:    _AccountsServiceV1_RevokeInvitation_Handler,
+		},
+		{
+			MethodName: "GetChallenge",
+			Handler:    _AccountsServiceV1_GetChallenge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "accounts_service_v1.proto",
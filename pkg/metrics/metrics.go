@@ -19,6 +19,7 @@ type Metrics interface {
 	IncGrpcPanicsTotal()
 	IncHits(status int, method, path string)
 	ObserveResponseTime(status int, method, path string, observeTime float64)
+	SetEventsSpoolDepth(spool string, depth int)
 }
 
 type PrometheusMetrics struct {
@@ -29,6 +30,7 @@ type PrometheusMetrics struct {
 	Times                 *prometheus.HistogramVec
 	RestPanicRecoverTotal prometheus.Counter
 	GrpcPanicRecoverTotal prometheus.Counter
+	EventsSpoolDepth      *prometheus.GaugeVec
 }
 
 func CreateMetrics(name string) (Metrics, error) {
@@ -95,6 +97,16 @@ func CreateMetrics(name string) (Metrics, error) {
 		return nil, err
 	}
 
+	metr.EventsSpoolDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: name + "_events_spool_depth",
+		},
+		[]string{"spool"},
+	)
+	if err := prometheus.Register(metr.EventsSpoolDepth); err != nil {
+		return nil, err
+	}
+
 	if err := prometheus.Register(collectors.NewBuildInfoCollector()); err != nil {
 		return nil, err
 	}
@@ -144,3 +156,7 @@ func (metr *PrometheusMetrics) IncRestPanicsTotal() {
 func (metr *PrometheusMetrics) IncGrpcPanicsTotal() {
 	metr.GrpcPanicRecoverTotal.Inc()
 }
+
+func (metr *PrometheusMetrics) SetEventsSpoolDepth(spool string, depth int) {
+	metr.EventsSpoolDepth.WithLabelValues(spool).Set(float64(depth))
+}